@@ -0,0 +1,86 @@
+// Command library shows how to embed the converter as a library: it loads a
+// specification and writes it as a PDF and as every other built-in format.
+//
+//	go run ./examples/library api.yaml out
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/GabrielNunesIT/openapi-converter/pkg/openapiconverter"
+)
+
+func main() {
+	if len(os.Args) != 3 {
+		fmt.Fprintln(os.Stderr, "usage: library <spec> <output dir>")
+		os.Exit(2)
+	}
+
+	if err := run(os.Args[1], os.Args[2]); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(specPath, outDir string) error {
+	doc, warnings, err := openapiconverter.LoadFile(specPath)
+	if err != nil {
+		return err
+	}
+
+	// Parts of the specification the documents leave out
+	for _, warning := range warnings {
+		fmt.Fprintf(os.Stderr, "warning: %s: %s\n", warning.Location, warning.Message)
+	}
+
+	if err := os.MkdirAll(outDir, 0o755); err != nil {
+		return err
+	}
+
+	// A converter configured directly through its options
+	pdf := openapiconverter.NewPDFConverter(
+		openapiconverter.WithPageSize(openapiconverter.PDFPageLetter),
+		openapiconverter.WithOrientation(openapiconverter.PDFLandscape),
+		openapiconverter.WithPDFGeneratedExamples(true),
+	)
+	if err := writeFile(filepath.Join(outDir, "api-letter.pdf"), pdf, doc); err != nil {
+		return err
+	}
+
+	// Every registered format, created by name
+	registry := openapiconverter.DefaultRegistry()
+	for _, format := range registry.Formats() {
+		if format.Extension == "" {
+			continue // Needs settings of its own, such as template files
+		}
+
+		converter, err := registry.New(format.Name, openapiconverter.Settings{GenerateExamples: true})
+		if err != nil {
+			return err
+		}
+
+		if err := writeFile(filepath.Join(outDir, "api"+format.Extension), converter, doc); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func writeFile(name string, converter openapiconverter.Converter, doc *openapiconverter.Document) error {
+	f, err := os.Create(name)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if err := converter.Convert(doc, f); err != nil {
+		return fmt.Errorf("%s: %w", converter.Format(), err)
+	}
+
+	fmt.Println("wrote", name)
+
+	return nil
+}
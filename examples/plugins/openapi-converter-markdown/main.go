@@ -0,0 +1,138 @@
+// Command openapi-converter-markdown is a sample converter plugin writing
+// documents as Markdown. Install it on PATH and run
+//
+//	openapi-converter -i api.yaml -o api.md -f markdown
+//
+// It reads the plugin request from stdin and writes the document to stdout;
+// errors go to stderr with a non-zero exit code. Only the fields it renders
+// are decoded, so new fields in the protocol do not affect it.
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+)
+
+// supportedProtocol is the plugin protocol version this plugin understands.
+const supportedProtocol = 1
+
+type request struct {
+	ProtocolVersion int      `json:"protocolVersion"`
+	Document        document `json:"document"`
+}
+
+type document struct {
+	Title       string `json:"title"`
+	Version     string `json:"version"`
+	Description string `json:"description"`
+	Servers     []struct {
+		URL         string `json:"url"`
+		Description string `json:"description"`
+	} `json:"servers"`
+	Paths []struct {
+		Path       string      `json:"path"`
+		Operations []operation `json:"operations"`
+	} `json:"paths"`
+}
+
+type operation struct {
+	Method      string `json:"method"`
+	Summary     string `json:"summary"`
+	Description string `json:"description"`
+	Parameters  []struct {
+		Name        string `json:"name"`
+		In          string `json:"in"`
+		Description string `json:"description"`
+		Required    bool   `json:"required"`
+		Schema      struct {
+			Type string `json:"type"`
+		} `json:"schema"`
+	} `json:"parameters"`
+	Responses []struct {
+		StatusCode  string `json:"statusCode"`
+		Description string `json:"description"`
+	} `json:"responses"`
+}
+
+func main() {
+	if err := run(os.Stdin, os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(r io.Reader, w io.Writer) error {
+	var req request
+	if err := json.NewDecoder(r).Decode(&req); err != nil {
+		return fmt.Errorf("invalid request: %w", err)
+	}
+
+	if req.ProtocolVersion != supportedProtocol {
+		return fmt.Errorf("unsupported protocol version %d (supported: %d)", req.ProtocolVersion, supportedProtocol)
+	}
+
+	out := bufio.NewWriter(w)
+	writeDocument(out, &req.Document)
+
+	return out.Flush()
+}
+
+func writeDocument(w *bufio.Writer, doc *document) {
+	fmt.Fprintf(w, "# %s\n\nVersion: %s\n", doc.Title, doc.Version)
+
+	if doc.Description != "" {
+		fmt.Fprintf(w, "\n%s\n", strings.TrimSpace(doc.Description))
+	}
+
+	if len(doc.Servers) > 0 {
+		w.WriteString("\n## Servers\n\n")
+		for _, server := range doc.Servers {
+			fmt.Fprintf(w, "- `%s` %s\n", server.URL, server.Description)
+		}
+	}
+
+	paths := doc.Paths
+	sort.Slice(paths, func(i, j int) bool { return paths[i].Path < paths[j].Path })
+
+	w.WriteString("\n## Endpoints\n")
+	for _, path := range paths {
+		for _, op := range path.Operations {
+			writeOperation(w, path.Path, &op)
+		}
+	}
+}
+
+func writeOperation(w *bufio.Writer, path string, op *operation) {
+	fmt.Fprintf(w, "\n### %s %s\n", strings.ToUpper(op.Method), path)
+
+	if op.Summary != "" {
+		fmt.Fprintf(w, "\n%s\n", op.Summary)
+	}
+	if op.Description != "" {
+		fmt.Fprintf(w, "\n%s\n", strings.TrimSpace(op.Description))
+	}
+
+	if len(op.Parameters) > 0 {
+		w.WriteString("\n| Name | In | Type | Required | Description |\n|---|---|---|---|---|\n")
+		for _, p := range op.Parameters {
+			fmt.Fprintf(w, "| %s | %s | %s | %t | %s |\n", p.Name, p.In, p.Schema.Type, p.Required, cell(p.Description))
+		}
+	}
+
+	if len(op.Responses) > 0 {
+		w.WriteString("\n| Status | Description |\n|---|---|\n")
+		for _, resp := range op.Responses {
+			fmt.Fprintf(w, "| %s | %s |\n", resp.StatusCode, cell(resp.Description))
+		}
+	}
+}
+
+// cell makes text safe to put in a table cell.
+func cell(s string) string {
+	return strings.NewReplacer("|", "\\|", "\n", " ").Replace(strings.TrimSpace(s))
+}
@@ -0,0 +1,262 @@
+// Package confluence provides a client for the Confluence REST API and a
+// publisher that keeps generated documentation pages up to date.
+package confluence
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Body representations accepted by the content API.
+const (
+	RepresentationADF     = "atlas_doc_format"
+	RepresentationStorage = "storage"
+)
+
+const defaultTimeout = 30 * time.Second
+
+// ErrNotFound is returned when a requested resource does not exist.
+var ErrNotFound = errors.New("not found")
+
+// Client is a minimal client for the Confluence REST content API, which is
+// shared by Confluence Cloud and Server/Data Center.
+type Client struct {
+	baseURL    string
+	httpClient *http.Client
+	user       string
+	token      string
+}
+
+// ClientOption configures a Client.
+type ClientOption func(*Client)
+
+// WithBasicAuth authenticates with a user name or email and an API token.
+func WithBasicAuth(user, token string) ClientOption {
+	return func(c *Client) {
+		c.user = user
+		c.token = token
+	}
+}
+
+// WithBearerToken authenticates with a personal access token.
+func WithBearerToken(token string) ClientOption {
+	return func(c *Client) {
+		c.user = ""
+		c.token = token
+	}
+}
+
+// WithHTTPClient sets the HTTP client used for requests.
+func WithHTTPClient(httpClient *http.Client) ClientOption {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+// NewClient creates a client for the Confluence instance at baseURL, such as
+// https://example.atlassian.net/wiki.
+func NewClient(baseURL string, opts ...ClientOption) *Client {
+	c := &Client{
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		httpClient: &http.Client{Timeout: defaultTimeout},
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+// Page is a Confluence page as exchanged with the content API.
+type Page struct {
+	ID        string    `json:"id,omitempty"`
+	Type      string    `json:"type"`
+	Title     string    `json:"title"`
+	Space     *Space    `json:"space,omitempty"`
+	Ancestors []PageRef `json:"ancestors,omitempty"`
+	Version   *Version  `json:"version,omitempty"`
+	Body      *PageBody `json:"body,omitempty"`
+}
+
+// Space identifies a space by key.
+type Space struct {
+	Key string `json:"key"`
+}
+
+// PageRef identifies a page by ID.
+type PageRef struct {
+	ID string `json:"id"`
+}
+
+// Version is the version of a page or content property.
+type Version struct {
+	Number int `json:"number"`
+}
+
+// PageBody holds the page content in one representation.
+type PageBody struct {
+	Storage *BodyValue `json:"storage,omitempty"`
+	ADF     *BodyValue `json:"atlas_doc_format,omitempty"` //nolint:tagliatelle // Field name defined by the API
+}
+
+// BodyValue is page content in the given representation.
+type BodyValue struct {
+	Value          string `json:"value"`
+	Representation string `json:"representation"`
+}
+
+// NewPageBody returns a body holding value in the given representation.
+func NewPageBody(representation, value string) *PageBody {
+	v := &BodyValue{Value: value, Representation: representation}
+	if representation == RepresentationADF {
+		return &PageBody{ADF: v}
+	}
+
+	return &PageBody{Storage: v}
+}
+
+// Property is a content property, a JSON value stored alongside a page.
+type Property struct {
+	Key     string   `json:"key"`
+	Value   any      `json:"value"`
+	Version *Version `json:"version,omitempty"`
+}
+
+// FindPage returns the page with the given title in a space, or ErrNotFound.
+func (c *Client) FindPage(ctx context.Context, space, title string) (*Page, error) {
+	query := url.Values{
+		"spaceKey": {space},
+		"title":    {title},
+		"type":     {"page"},
+		"expand":   {"version,ancestors"},
+	}
+
+	var result struct {
+		Results []Page `json:"results"`
+	}
+	if err := c.do(ctx, http.MethodGet, "/rest/api/content?"+query.Encode(), nil, &result); err != nil {
+		return nil, fmt.Errorf("failed to find page %q: %w", title, err)
+	}
+
+	if len(result.Results) == 0 {
+		return nil, fmt.Errorf("page %q: %w", title, ErrNotFound)
+	}
+
+	return &result.Results[0], nil
+}
+
+// CreatePage creates a page and returns it as stored.
+func (c *Client) CreatePage(ctx context.Context, page *Page) (*Page, error) {
+	var created Page
+	if err := c.do(ctx, http.MethodPost, "/rest/api/content", page, &created); err != nil {
+		return nil, fmt.Errorf("failed to create page %q: %w", page.Title, err)
+	}
+
+	return &created, nil
+}
+
+// UpdatePage stores a new version of a page. The page version must be the
+// number of the version being created.
+func (c *Client) UpdatePage(ctx context.Context, page *Page) (*Page, error) {
+	var updated Page
+	if err := c.do(ctx, http.MethodPut, "/rest/api/content/"+url.PathEscape(page.ID), page, &updated); err != nil {
+		return nil, fmt.Errorf("failed to update page %q: %w", page.Title, err)
+	}
+
+	return &updated, nil
+}
+
+// GetProperty returns a content property of a page, or ErrNotFound.
+func (c *Client) GetProperty(ctx context.Context, pageID, key string) (*Property, error) {
+	var prop Property
+	if err := c.do(ctx, http.MethodGet, propertyPath(pageID, key), nil, &prop); err != nil {
+		return nil, fmt.Errorf("failed to get property %s of page %s: %w", key, pageID, err)
+	}
+
+	return &prop, nil
+}
+
+// SetProperty creates a content property, or updates it when prop carries
+// the version of an existing one.
+func (c *Client) SetProperty(ctx context.Context, pageID string, prop *Property) error {
+	var err error
+	if prop.Version == nil {
+		err = c.do(ctx, http.MethodPost, "/rest/api/content/"+url.PathEscape(pageID)+"/property", prop, nil)
+	} else {
+		next := *prop
+		next.Version = &Version{Number: prop.Version.Number + 1}
+		err = c.do(ctx, http.MethodPut, propertyPath(pageID, prop.Key), &next, nil)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to set property %s of page %s: %w", prop.Key, pageID, err)
+	}
+
+	return nil
+}
+
+func propertyPath(pageID, key string) string {
+	return "/rest/api/content/" + url.PathEscape(pageID) + "/property/" + url.PathEscape(key)
+}
+
+// do sends a JSON request and decodes the JSON response into out, if set.
+func (c *Client) do(ctx context.Context, method, path string, in, out any) error {
+	var body io.Reader
+	if in != nil {
+		data, err := json.Marshal(in)
+		if err != nil {
+			return fmt.Errorf("failed to encode request: %w", err)
+		}
+		body = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, body)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Accept", "application/json")
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	switch {
+	case c.user != "":
+		req.SetBasicAuth(c.user, c.token)
+	case c.token != "":
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return ErrNotFound
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("%s %s: %s: %s", method, path, resp.Status, strings.TrimSpace(string(msg)))
+	}
+
+	if out == nil {
+		return nil
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return nil
+}
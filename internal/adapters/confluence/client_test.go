@@ -0,0 +1,137 @@
+package confluence_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/GabrielNunesIT/openapi-converter/internal/adapters/confluence"
+	"github.com/GabrielNunesIT/openapi-converter/internal/adapters/confluence/confluencetest"
+)
+
+func TestClientAuthentication(t *testing.T) {
+	tests := []struct {
+		name string
+		opt  confluence.ClientOption
+		want string
+	}{
+		{name: "basic", opt: confluence.WithBasicAuth("user", "secret"), want: "Basic dXNlcjpzZWNyZXQ="},
+		{name: "bearer", opt: confluence.WithBearerToken("secret"), want: "Bearer secret"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got string
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				got = r.Header.Get("Authorization")
+				_, _ = w.Write([]byte(`{"results": []}`))
+			}))
+			defer srv.Close()
+
+			client := confluence.NewClient(srv.URL, tt.opt)
+			if _, err := client.FindPage(context.Background(), "DOC", "API"); !errors.Is(err, confluence.ErrNotFound) {
+				t.Fatalf("FindPage() error = %v, want ErrNotFound", err)
+			}
+
+			if got != tt.want {
+				t.Errorf("Authorization = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClientPages(t *testing.T) {
+	srv := confluencetest.NewServer()
+	defer srv.Close()
+
+	ctx := context.Background()
+	client := confluence.NewClient(srv.URL + "/")
+
+	created, err := client.CreatePage(ctx, &confluence.Page{
+		Type:  "page",
+		Title: "API",
+		Space: &confluence.Space{Key: "DOC"},
+		Body:  confluence.NewPageBody(confluence.RepresentationStorage, "<p>v1</p>"),
+	})
+	if err != nil {
+		t.Fatalf("CreatePage() error = %v", err)
+	}
+
+	found, err := client.FindPage(ctx, "DOC", "API")
+	if err != nil {
+		t.Fatalf("FindPage() error = %v", err)
+	}
+	if found.ID != created.ID || found.Version.Number != 1 {
+		t.Fatalf("FindPage() = %+v, want page %s at version 1", found, created.ID)
+	}
+
+	_, err = client.UpdatePage(ctx, &confluence.Page{
+		ID:      created.ID,
+		Type:    "page",
+		Title:   "API",
+		Version: &confluence.Version{Number: 2},
+		Body:    confluence.NewPageBody(confluence.RepresentationStorage, "<p>v2</p>"),
+	})
+	if err != nil {
+		t.Fatalf("UpdatePage() error = %v", err)
+	}
+
+	if page := srv.Page("API"); page.Version.Number != 2 || page.Body.Storage.Value != "<p>v2</p>" {
+		t.Errorf("stored page = %+v, want version 2 with the new body", page)
+	}
+}
+
+func TestClientProperties(t *testing.T) {
+	srv := confluencetest.NewServer()
+	defer srv.Close()
+
+	ctx := context.Background()
+	client := confluence.NewClient(srv.URL)
+	pageID := srv.AddPage(confluence.Page{Title: "API", Version: &confluence.Version{Number: 1}})
+
+	if _, err := client.GetProperty(ctx, pageID, "hash"); !errors.Is(err, confluence.ErrNotFound) {
+		t.Fatalf("GetProperty() error = %v, want ErrNotFound", err)
+	}
+
+	if err := client.SetProperty(ctx, pageID, &confluence.Property{Key: "hash", Value: "a"}); err != nil {
+		t.Fatalf("SetProperty() create error = %v", err)
+	}
+
+	prop, err := client.GetProperty(ctx, pageID, "hash")
+	if err != nil {
+		t.Fatalf("GetProperty() error = %v", err)
+	}
+
+	prop.Value = "b"
+	if err := client.SetProperty(ctx, pageID, prop); err != nil {
+		t.Fatalf("SetProperty() update error = %v", err)
+	}
+
+	if got := srv.Property(pageID, "hash"); got.Value != "b" || got.Version.Number != 2 {
+		t.Errorf("stored property = %+v, want value b at version 2", got)
+	}
+}
+
+func TestClientErrorStatus(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, "permission denied", http.StatusForbidden)
+	}))
+	defer srv.Close()
+
+	_, err := confluence.NewClient(srv.URL).CreatePage(context.Background(), &confluence.Page{Title: "API"})
+	if err == nil {
+		t.Fatal("CreatePage() error = nil, want an error")
+	}
+	if errors.Is(err, confluence.ErrNotFound) {
+		t.Errorf("CreatePage() error = %v, should not be ErrNotFound", err)
+	}
+
+	for _, want := range []string{"403 Forbidden", "permission denied", `"API"`} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("CreatePage() error = %q, want it to contain %q", err, want)
+		}
+	}
+}
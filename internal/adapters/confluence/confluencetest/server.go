@@ -0,0 +1,215 @@
+// Package confluencetest provides an in-memory Confluence content API for
+// tests.
+package confluencetest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+
+	"github.com/GabrielNunesIT/openapi-converter/internal/adapters/confluence"
+)
+
+// Server is a Confluence content API holding pages and their content
+// properties in memory.
+type Server struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	pages    map[string]*confluence.Page
+	props    map[string]*confluence.Property
+	requests []string
+	nextID   int
+}
+
+// NewServer starts a server with no pages. Close it when done.
+func NewServer() *Server {
+	s := &Server{
+		pages: make(map[string]*confluence.Page),
+		props: make(map[string]*confluence.Property),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /rest/api/content", s.findPage)
+	mux.HandleFunc("POST /rest/api/content", s.createPage)
+	mux.HandleFunc("PUT /rest/api/content/{id}", s.updatePage)
+	mux.HandleFunc("GET /rest/api/content/{id}/property/{key}", s.getProperty)
+	mux.HandleFunc("POST /rest/api/content/{id}/property", s.createProperty)
+	mux.HandleFunc("PUT /rest/api/content/{id}/property/{key}", s.updateProperty)
+
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		s.requests = append(s.requests, r.Method+" "+r.URL.Path)
+		mux.ServeHTTP(w, r)
+	}))
+
+	return s
+}
+
+// AddPage stores a page as if it had been published before and returns its
+// ID.
+func (s *Server) AddPage(page confluence.Page) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.nextID++
+	page.ID = strconv.Itoa(s.nextID)
+	s.pages[page.ID] = &page
+
+	return page.ID
+}
+
+// SetProperty stores a content property of a page.
+func (s *Server) SetProperty(pageID string, prop confluence.Property) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.props[pageID+"/"+prop.Key] = &prop
+}
+
+// Page returns the page with the given title, or nil.
+func (s *Server) Page(title string) *confluence.Page {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.pageByTitle(title)
+}
+
+// Property returns a content property of a page, or nil.
+func (s *Server) Property(pageID, key string) *confluence.Property {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.props[pageID+"/"+key]
+}
+
+// Requests returns the method and path of every request received.
+func (s *Server) Requests() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]string(nil), s.requests...)
+}
+
+func (s *Server) pageByTitle(title string) *confluence.Page {
+	for _, page := range s.pages {
+		if page.Title == title {
+			return page
+		}
+	}
+
+	return nil
+}
+
+func (s *Server) findPage(w http.ResponseWriter, r *http.Request) {
+	results := []confluence.Page{}
+	if page := s.pageByTitle(r.URL.Query().Get("title")); page != nil {
+		results = append(results, *page)
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{"results": results})
+}
+
+func (s *Server) createPage(w http.ResponseWriter, r *http.Request) {
+	var page confluence.Page
+	if !readJSON(w, r, &page) {
+		return
+	}
+
+	s.nextID++
+	page.ID = strconv.Itoa(s.nextID)
+	page.Version = &confluence.Version{Number: 1}
+	s.pages[page.ID] = &page
+
+	writeJSON(w, http.StatusOK, page)
+}
+
+func (s *Server) updatePage(w http.ResponseWriter, r *http.Request) {
+	existing, ok := s.pages[r.PathValue("id")]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	var page confluence.Page
+	if !readJSON(w, r, &page) {
+		return
+	}
+	if page.Version == nil || page.Version.Number != existing.Version.Number+1 {
+		http.Error(w, "version must be the next version of the page", http.StatusConflict)
+		return
+	}
+
+	s.pages[existing.ID] = &page
+
+	writeJSON(w, http.StatusOK, page)
+}
+
+func (s *Server) getProperty(w http.ResponseWriter, r *http.Request) {
+	prop, ok := s.props[r.PathValue("id")+"/"+r.PathValue("key")]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, prop)
+}
+
+func (s *Server) createProperty(w http.ResponseWriter, r *http.Request) {
+	var prop confluence.Property
+	if !readJSON(w, r, &prop) {
+		return
+	}
+
+	key := r.PathValue("id") + "/" + prop.Key
+	if _, ok := s.props[key]; ok {
+		http.Error(w, "property already exists", http.StatusConflict)
+		return
+	}
+
+	prop.Version = &confluence.Version{Number: 1}
+	s.props[key] = &prop
+
+	writeJSON(w, http.StatusOK, prop)
+}
+
+func (s *Server) updateProperty(w http.ResponseWriter, r *http.Request) {
+	key := r.PathValue("id") + "/" + r.PathValue("key")
+	existing, ok := s.props[key]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	var prop confluence.Property
+	if !readJSON(w, r, &prop) {
+		return
+	}
+	if prop.Version == nil || prop.Version.Number != existing.Version.Number+1 {
+		http.Error(w, "version must be the next version of the property", http.StatusConflict)
+		return
+	}
+
+	s.props[key] = &prop
+
+	writeJSON(w, http.StatusOK, prop)
+}
+
+func readJSON(w http.ResponseWriter, r *http.Request, v any) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return false
+	}
+
+	return true
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
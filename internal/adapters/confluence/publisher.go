@@ -0,0 +1,107 @@
+package confluence
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+)
+
+// hashProperty is the content property holding the hash of the published
+// content, used to skip updates that would not change the page.
+const hashProperty = "openapi-converter-hash"
+
+// Publish actions reported in a Result.
+const (
+	ActionCreated   = "created"
+	ActionUpdated   = "updated"
+	ActionUnchanged = "unchanged"
+)
+
+// Publisher creates or updates pages in a Confluence space.
+type Publisher struct {
+	client         *Client
+	space          string
+	representation string
+}
+
+// NewPublisher creates a publisher writing pages to a space with bodies in
+// the given representation.
+func NewPublisher(client *Client, space, representation string) *Publisher {
+	return &Publisher{
+		client:         client,
+		space:          space,
+		representation: representation,
+	}
+}
+
+// Result describes the outcome of publishing a page.
+type Result struct {
+	ID     string
+	Title  string
+	Action string
+}
+
+// Publish creates the page with the given title under parentID, or updates
+// it when it already exists. Pages whose content has not changed since the
+// last publish are left alone so their version history stays clean.
+func (p *Publisher) Publish(ctx context.Context, title, parentID string, content []byte) (*Result, error) {
+	sum := sha256.Sum256(append([]byte(p.representation+"\n"), content...))
+	hash := hex.EncodeToString(sum[:])
+
+	page := &Page{
+		Type:  "page",
+		Title: title,
+		Space: &Space{Key: p.space},
+		Body:  NewPageBody(p.representation, string(content)),
+	}
+	if parentID != "" {
+		page.Ancestors = []PageRef{{ID: parentID}}
+	}
+
+	existing, err := p.client.FindPage(ctx, p.space, title)
+	if errors.Is(err, ErrNotFound) {
+		created, err := p.client.CreatePage(ctx, page)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := p.client.SetProperty(ctx, created.ID, &Property{Key: hashProperty, Value: hash}); err != nil {
+			return nil, err
+		}
+
+		return &Result{ID: created.ID, Title: title, Action: ActionCreated}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	prop, err := p.client.GetProperty(ctx, existing.ID, hashProperty)
+	switch {
+	case errors.Is(err, ErrNotFound):
+		prop = &Property{Key: hashProperty}
+	case err != nil:
+		return nil, err
+	case prop.Value == hash:
+		return &Result{ID: existing.ID, Title: title, Action: ActionUnchanged}, nil
+	}
+
+	if existing.Version == nil {
+		return nil, fmt.Errorf("page %q has no version information", title)
+	}
+
+	page.ID = existing.ID
+	page.Version = &Version{Number: existing.Version.Number + 1}
+
+	if _, err := p.client.UpdatePage(ctx, page); err != nil {
+		return nil, err
+	}
+
+	prop.Value = hash
+	if err := p.client.SetProperty(ctx, existing.ID, prop); err != nil {
+		return nil, err
+	}
+
+	return &Result{ID: existing.ID, Title: title, Action: ActionUpdated}, nil
+}
@@ -0,0 +1,173 @@
+package confluence_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/GabrielNunesIT/openapi-converter/internal/adapters/confluence"
+	"github.com/GabrielNunesIT/openapi-converter/internal/adapters/confluence/confluencetest"
+)
+
+const hashProperty = "openapi-converter-hash"
+
+func TestPublisherPublish(t *testing.T) {
+	srv := confluencetest.NewServer()
+	defer srv.Close()
+
+	ctx := context.Background()
+	publisher := confluence.NewPublisher(confluence.NewClient(srv.URL), "DOC", confluence.RepresentationStorage)
+
+	steps := []struct {
+		name    string
+		content string
+		action  string
+		version int
+	}{
+		{name: "create", content: "<p>v1</p>", action: confluence.ActionCreated, version: 1},
+		{name: "unchanged", content: "<p>v1</p>", action: confluence.ActionUnchanged, version: 1},
+		{name: "update", content: "<p>v2</p>", action: confluence.ActionUpdated, version: 2},
+		{name: "unchanged after update", content: "<p>v2</p>", action: confluence.ActionUnchanged, version: 2},
+	}
+
+	for _, step := range steps {
+		result, err := publisher.Publish(ctx, "API", "", []byte(step.content))
+		if err != nil {
+			t.Fatalf("%s: Publish() error = %v", step.name, err)
+		}
+		if result.Action != step.action {
+			t.Errorf("%s: action = %s, want %s", step.name, result.Action, step.action)
+		}
+
+		page := srv.Page("API")
+		if page.ID != result.ID {
+			t.Errorf("%s: result ID = %s, want %s", step.name, result.ID, page.ID)
+		}
+		if page.Version.Number != step.version {
+			t.Errorf("%s: page version = %d, want %d", step.name, page.Version.Number, step.version)
+		}
+		if page.Body.Storage.Value != step.content {
+			t.Errorf("%s: page body = %q, want %q", step.name, page.Body.Storage.Value, step.content)
+		}
+	}
+
+	// The unchanged publishes must not write anything
+	var writes int
+	for _, req := range srv.Requests() {
+		if !strings.HasPrefix(req, http.MethodGet) {
+			writes++
+		}
+	}
+	if writes != 4 {
+		t.Errorf("got %d write requests, want 4 (page and hash for the create and the update): %v", writes, srv.Requests())
+	}
+}
+
+func TestPublisherPublishWithoutHash(t *testing.T) {
+	srv := confluencetest.NewServer()
+	defer srv.Close()
+
+	// A page created by hand has no hash and is always updated
+	pageID := srv.AddPage(confluence.Page{Type: "page", Title: "API", Version: &confluence.Version{Number: 7}})
+
+	publisher := confluence.NewPublisher(confluence.NewClient(srv.URL), "DOC", confluence.RepresentationADF)
+	result, err := publisher.Publish(context.Background(), "API", "", []byte(`{"type":"doc"}`))
+	if err != nil {
+		t.Fatalf("Publish() error = %v", err)
+	}
+
+	if result.Action != confluence.ActionUpdated {
+		t.Errorf("action = %s, want %s", result.Action, confluence.ActionUpdated)
+	}
+	if page := srv.Page("API"); page.Version.Number != 8 || page.Body.ADF == nil {
+		t.Errorf("page = %+v, want version 8 with an ADF body", page)
+	}
+	if prop := srv.Property(pageID, hashProperty); prop == nil || prop.Version.Number != 1 {
+		t.Errorf("hash property = %+v, want a new property", prop)
+	}
+}
+
+func TestPublisherPublishChildPages(t *testing.T) {
+	srv := confluencetest.NewServer()
+	defer srv.Close()
+
+	ctx := context.Background()
+	publisher := confluence.NewPublisher(confluence.NewClient(srv.URL), "DOC", confluence.RepresentationStorage)
+
+	root, err := publisher.Publish(ctx, "API", "100", []byte("<p>overview</p>"))
+	if err != nil {
+		t.Fatalf("Publish() root error = %v", err)
+	}
+
+	for _, tag := range []string{"pets", "store"} {
+		if _, err := publisher.Publish(ctx, "API - "+tag, root.ID, []byte("<p>"+tag+"</p>")); err != nil {
+			t.Fatalf("Publish() %s error = %v", tag, err)
+		}
+	}
+
+	tests := []struct {
+		title  string
+		parent string
+	}{
+		{title: "API", parent: "100"},
+		{title: "API - pets", parent: root.ID},
+		{title: "API - store", parent: root.ID},
+	}
+
+	for _, tt := range tests {
+		page := srv.Page(tt.title)
+		if page == nil {
+			t.Errorf("page %q was not created", tt.title)
+			continue
+		}
+		if !slices.Equal(page.Ancestors, []confluence.PageRef{{ID: tt.parent}}) {
+			t.Errorf("page %q ancestors = %v, want [%s]", tt.title, page.Ancestors, tt.parent)
+		}
+		if page.Space == nil || page.Space.Key != "DOC" {
+			t.Errorf("page %q space = %v, want DOC", tt.title, page.Space)
+		}
+	}
+}
+
+func TestPublisherPublishErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		failOn string
+		want   string
+	}{
+		{name: "find", failOn: "GET /rest/api/content", want: "failed to find page"},
+		{name: "create", failOn: "POST /rest/api/content", want: "failed to create page"},
+		{name: "hash", failOn: "POST /rest/api/content/1/property", want: "failed to set property"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := confluencetest.NewServer()
+			defer fake.Close()
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method+" "+r.URL.Path == tt.failOn {
+					http.Error(w, "backend unavailable", http.StatusBadGateway)
+					return
+				}
+				fake.Config.Handler.ServeHTTP(w, r)
+			}))
+			defer srv.Close()
+
+			publisher := confluence.NewPublisher(confluence.NewClient(srv.URL), "DOC", confluence.RepresentationStorage)
+			_, err := publisher.Publish(context.Background(), "API", "", []byte("<p>v1</p>"))
+			if err == nil {
+				t.Fatal("Publish() error = nil, want an error")
+			}
+
+			for _, want := range []string{tt.want, "502 Bad Gateway", "backend unavailable"} {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("Publish() error = %q, want it to contain %q", err, want)
+				}
+			}
+		})
+	}
+}
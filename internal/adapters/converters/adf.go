@@ -1,6 +1,7 @@
 package converters
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -9,14 +10,53 @@ import (
 	"github.com/GabrielNunesIT/openapi-converter/internal/domain"
 )
 
-const adfFormat = "confluence"
+const (
+	adfFormat = "confluence"
+
+	// adfExpandThreshold is the number of properties above which a schema
+	// table is collapsed into an expand node.
+	adfExpandThreshold = 8
+
+	// adfTableWidth is the width in pixels column widths are scaled to.
+	adfTableWidth = 760
+)
+
+// adfMethodColors maps HTTP methods to status lozenge colors.
+var adfMethodColors = map[string]string{
+	"GET":    "green",
+	"POST":   "blue",
+	"PUT":    "yellow",
+	"PATCH":  "yellow",
+	"DELETE": "red",
+}
 
 // ADFConverter converts OpenAPI documents to Atlassian Document Format (ADF) for Confluence.
-type ADFConverter struct{}
+type ADFConverter struct {
+	generateExamples bool
+	synth            *exampleSynthesizer // Set per conversion when generateExamples is enabled
+	conv             *conversion         // Progress of the current conversion
+}
+
+// ADFOption configures an ADFConverter.
+type ADFOption func(*ADFConverter)
+
+// WithADFGeneratedExamples enables synthesizing examples from schemas for
+// request and response bodies that have none.
+func WithADFGeneratedExamples(enabled bool) ADFOption {
+	return func(c *ADFConverter) {
+		c.generateExamples = enabled
+	}
+}
 
 // NewADFConverter creates a new ADF converter.
-func NewADFConverter() *ADFConverter {
-	return &ADFConverter{}
+func NewADFConverter(opts ...ADFOption) *ADFConverter {
+	c := &ADFConverter{}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
 }
 
 // Format returns the output format name.
@@ -40,9 +80,16 @@ type adfNode struct {
 }
 
 type adfAttrs struct {
-	Level int    `json:"level,omitempty"`
-	Order int    `json:"order,omitempty"`
-	URL   string `json:"url,omitempty"`
+	Level     int    `json:"level,omitempty"`
+	Order     int    `json:"order,omitempty"`
+	URL       string `json:"url,omitempty"`
+	Language  string `json:"language,omitempty"`
+	PanelType string `json:"panelType,omitempty"`
+	Title     string `json:"title,omitempty"`
+	Text      string `json:"text,omitempty"`
+	Color     string `json:"color,omitempty"`
+	Layout    string `json:"layout,omitempty"`
+	Colwidth  []int  `json:"colwidth,omitempty"`
 }
 
 type adfMark struct {
@@ -50,14 +97,22 @@ type adfMark struct {
 	Attrs map[string]any `json:"attrs,omitempty"`
 }
 
-type adfEndpointRef struct {
-	path      string
-	method    string
-	operation domain.Operation
-}
-
 // Convert transforms an OpenAPI document to ADF JSON format.
 func (c *ADFConverter) Convert(doc *domain.OpenAPIDocument, output io.Writer) error {
+	_, err := c.ConvertContext(context.Background(), doc, output, domain.ConvertOptions{})
+
+	return err
+}
+
+// ConvertContext implements domain.ContextConverter.
+func (c *ADFConverter) ConvertContext(ctx context.Context, doc *domain.OpenAPIDocument, output io.Writer,
+	opts domain.ConvertOptions,
+) ([]domain.Warning, error) {
+	c.synth = nil
+	if c.generateExamples {
+		c.synth = newExampleSynthesizer(doc.Components)
+	}
+
 	adf := &adfDocument{
 		Version: 1,
 		Type:    "doc",
@@ -68,152 +123,77 @@ func (c *ADFConverter) Convert(doc *domain.OpenAPIDocument, output io.Writer) er
 	adf.Content = append(adf.Content, c.heading(doc.Title, 1))
 	adf.Content = append(adf.Content, c.paragraph(fmt.Sprintf("Version: %s", doc.Version)))
 
-	// Description
-	if doc.Description != "" {
-		adf.Content = append(adf.Content, c.heading("Description", 2))
-		adf.Content = append(adf.Content, c.paragraph(doc.Description))
-	}
-
-	// Servers
-	if len(doc.Servers) > 0 {
-		adf.Content = append(adf.Content, c.heading("Servers", 2))
-		adf.Content = append(adf.Content, c.serverList(doc.Servers))
-	}
-
-	// Endpoints grouped by tags
-	if len(doc.Paths) > 0 {
-		adf.Content = append(adf.Content, c.heading("API Endpoints", 2))
+	layout := newDocumentLayout(doc)
+	c.conv = newConversion(ctx, layout, opts)
 
-		tagPaths := c.groupPathsByTag(doc)
-		tags := make([]string, 0, len(tagPaths))
-		for tag := range tagPaths {
-			tags = append(tags, tag)
+	for _, section := range layout.sections {
+		if !c.conv.startSection(section) {
+			break
 		}
-		sort.Strings(tags)
-
-		for _, tag := range tags {
-			// Tag header
-			adf.Content = append(adf.Content, c.heading(tag, 3))
-
-			// Add components used by this tag's endpoints
-			tagComponents := c.collectTagComponents(tagPaths[tag])
-			if len(tagComponents) > 0 {
-				adf.Content = append(adf.Content, c.tagComponentNodes(tagComponents, doc.Components)...)
-			}
 
-			// Add endpoints
-			for _, ep := range tagPaths[tag] {
-				adf.Content = append(adf.Content, c.operationNodes(ep.path, ep.operation)...)
-			}
+		adf.Content = append(adf.Content, c.heading(section.title, 2))
+
+		switch section.kind {
+		case sectionDescription:
+			adf.Content = append(adf.Content, c.markdownNodes(doc.Description)...)
+		case sectionAuthentication:
+			adf.Content = append(adf.Content, c.securityNodes(doc.SecuritySchemes)...)
+		case sectionServers:
+			adf.Content = append(adf.Content, c.serverList(doc.Servers))
+		case sectionEndpoints:
+			adf.Content = append(adf.Content, c.tagNodes(layout.tags)...)
 		}
 	}
 
+	if err := c.conv.finish(); err != nil {
+		return c.conv.warnings, err
+	}
+
 	encoder := json.NewEncoder(output)
 	encoder.SetIndent("", "  ")
 
 	if err := encoder.Encode(adf); err != nil {
-		return fmt.Errorf("failed to encode ADF: %w", err)
+		return c.conv.warnings, fmt.Errorf("failed to encode ADF: %w", err)
 	}
 
-	return nil
+	return c.conv.warnings, nil
 }
 
-// groupPathsByTag groups paths by their operation tags.
-func (c *ADFConverter) groupPathsByTag(doc *domain.OpenAPIDocument) map[string][]adfEndpointRef {
-	result := make(map[string][]adfEndpointRef)
+// tagNodes generates ADF nodes for the tags of the API Endpoints section.
+func (c *ADFConverter) tagNodes(tags []layoutTag) []adfNode {
+	var nodes []adfNode
 
-	for _, path := range doc.Paths {
-		for _, op := range path.Operations {
-			tags := op.Tags
-			if len(tags) == 0 {
-				tags = []string{"Default"}
-			}
+	for _, tag := range tags {
+		nodes = append(nodes, c.heading(tag.name, 3))
 
-			for _, tag := range tags {
-				result[tag] = append(result[tag], adfEndpointRef{
-					path:      path.Path,
-					method:    op.Method,
-					operation: op,
-				})
-			}
+		if tag.description != "" {
+			nodes = append(nodes, c.panel("info", c.markdownNodes(tag.description)))
 		}
-	}
-
-	// Sort endpoints within each tag by path then method
-	for tag := range result {
-		sort.Slice(result[tag], func(i, j int) bool {
-			if result[tag][i].path == result[tag][j].path {
-				return result[tag][i].method < result[tag][j].method
-			}
 
-			return result[tag][i].path < result[tag][j].path
-		})
-	}
-
-	return result
-}
-
-// collectTagComponents gathers all unique component names used by endpoints in a tag.
-func (c *ADFConverter) collectTagComponents(endpoints []adfEndpointRef) []string {
-	componentSet := make(map[string]struct{})
-
-	for _, ep := range endpoints {
-		// Check request body
-		if ep.operation.RequestBody != nil {
-			for _, media := range ep.operation.RequestBody.Content {
-				c.collectSchemaRefs(media.Schema, componentSet)
-			}
+		// Add components used by this tag's endpoints
+		if len(tag.components) > 0 {
+			nodes = append(nodes, c.tagComponentNodes(tag.components)...)
 		}
 
-		// Check responses
-		for _, resp := range ep.operation.Responses {
-			for _, media := range resp.Content {
-				c.collectSchemaRefs(media.Schema, componentSet)
+		// Add endpoints
+		for _, ep := range tag.endpoints {
+			if !c.conv.startEndpoint(ep) {
+				return nodes
 			}
-		}
 
-		// Check parameters
-		for _, param := range ep.operation.Parameters {
-			c.collectSchemaRefs(param.Schema, componentSet)
+			nodes = append(nodes, c.operationNodes(ep.path, ep.operation)...)
 		}
 	}
 
-	// Convert set to sorted slice
-	components := make([]string, 0, len(componentSet))
-	for name := range componentSet {
-		components = append(components, name)
-	}
-	sort.Strings(components)
-
-	return components
-}
-
-// collectSchemaRefs recursively collects component references from a schema.
-func (c *ADFConverter) collectSchemaRefs(schema domain.Schema, refs map[string]struct{}) {
-	if schema.Ref != "" {
-		refs[extractRefName(schema.Ref)] = struct{}{}
-	}
-
-	for _, prop := range schema.Properties {
-		c.collectSchemaRefs(prop, refs)
-	}
-
-	if schema.Items != nil {
-		c.collectSchemaRefs(*schema.Items, refs)
-	}
+	return nodes
 }
 
 // tagComponentNodes generates ADF nodes for component schemas used in a tag.
-func (c *ADFConverter) tagComponentNodes(componentNames []string, components map[string]domain.Schema) []adfNode {
+func (c *ADFConverter) tagComponentNodes(components []layoutComponent) []adfNode {
 	nodes := []adfNode{c.heading("Schemas Used", 4)}
 
-	for _, name := range componentNames {
-		schema, exists := components[name]
-		if !exists {
-			continue
-		}
-
-		nodes = append(nodes, c.componentSchemaNodes(name, schema)...)
+	for _, component := range components {
+		nodes = append(nodes, c.componentSchemaNodes(component.name, component.schema)...)
 	}
 
 	return nodes
@@ -242,19 +222,14 @@ func (c *ADFConverter) componentSchemaNodes(name string, schema domain.Schema) [
 
 	// Description
 	if schema.Description != "" {
-		nodes = append(nodes, c.paragraph(schema.Description))
+		nodes = append(nodes, c.markdownNodes(schema.Description)...)
 	}
 
-	// Properties as bullet list
+	// Properties as a table, collapsed when the schema is large
 	if len(schema.Properties) > 0 {
-		propNames := make([]string, 0, len(schema.Properties))
-		for propName := range schema.Properties {
-			propNames = append(propNames, propName)
-		}
-		sort.Strings(propNames)
+		rows := make([][]adfNode, 0, len(schema.Properties))
 
-		items := make([]adfNode, 0, len(propNames))
-		for _, propName := range propNames {
+		for _, propName := range sortedKeys(schema.Properties) {
 			prop := schema.Properties[propName]
 			propType := prop.Type
 			if prop.Ref != "" {
@@ -263,24 +238,48 @@ func (c *ADFConverter) componentSchemaNodes(name string, schema domain.Schema) [
 				propType = fmt.Sprintf("%s (%s)", prop.Type, prop.Format)
 			}
 
-			items = append(items, adfNode{
-				Type: "listItem",
-				Content: []adfNode{
-					{
-						Type: "paragraph",
-						Content: []adfNode{
-							c.codeText(propName),
-							{Type: "text", Text: fmt.Sprintf(" (%s)", propType)},
-						},
-					},
-				},
+			rows = append(rows, []adfNode{
+				c.codeText(propName),
+				c.text(propType),
+				c.text(markdownToText(prop.Description)),
 			})
 		}
 
+		table := c.table([]int{50, 50, 90}, []string{"Name", "Type", "Description"}, rows)
+		if len(rows) > adfExpandThreshold {
+			table = adfNode{
+				Type:    "expand",
+				Attrs:   &adfAttrs{Title: fmt.Sprintf("Properties (%d)", len(rows))},
+				Content: []adfNode{table},
+			}
+		}
+
+		nodes = append(nodes, table)
+	}
+
+	return nodes
+}
+
+// exampleNodes renders each example as a titled codeBlock. The language
+// attribute lets Confluence apply its own syntax highlighting.
+func (c *ADFConverter) exampleNodes(examples []namedExample) []adfNode {
+	nodes := make([]adfNode, 0, len(examples)*2)
+
+	for _, ex := range examples {
+		code, lang := formatExample(ex.mediaType, ex.value)
+
+		block := adfNode{Type: "codeBlock"}
+		if lang != exampleForm && lang != exampleText {
+			block.Attrs = &adfAttrs{Language: lang}
+		}
+		if code != "" {
+			block.Content = []adfNode{{Type: "text", Text: code}}
+		}
+
 		nodes = append(nodes, adfNode{
-			Type:    "bulletList",
-			Content: items,
-		})
+			Type:    "paragraph",
+			Content: []adfNode{{Type: "text", Text: ex.title, Marks: []adfMark{{Type: "em"}}}},
+		}, block)
 	}
 
 	return nodes
@@ -305,6 +304,10 @@ func (c *ADFConverter) paragraph(text string) adfNode {
 	}
 }
 
+func (c *ADFConverter) text(text string) adfNode {
+	return adfNode{Type: "text", Text: text}
+}
+
 func (c *ADFConverter) boldText(text string) adfNode {
 	return adfNode{
 		Type: "text",
@@ -325,6 +328,34 @@ func (c *ADFConverter) codeText(text string) adfNode {
 	}
 }
 
+// securityNodes generates ADF nodes describing the security schemes.
+func (c *ADFConverter) securityNodes(schemes map[string]domain.SecurityScheme) []adfNode {
+	var nodes []adfNode
+
+	for _, name := range sortedKeys(schemes) {
+		scheme := schemes[name]
+
+		nodes = append(nodes, adfNode{Type: "paragraph", Content: []adfNode{c.boldText(name)}})
+		nodes = append(nodes, c.paragraph(fmt.Sprintf("Type: %s", scheme.Type)))
+
+		if scheme.In != "" {
+			nodes = append(nodes, c.paragraph(fmt.Sprintf("In: %s", scheme.In)))
+		}
+		if scheme.Name != "" && scheme.Name != name {
+			nodes = append(nodes, c.paragraph(fmt.Sprintf("Name: %s", scheme.Name)))
+		}
+		if scheme.Scheme != "" {
+			nodes = append(nodes, c.paragraph(fmt.Sprintf("Scheme: %s", scheme.Scheme)))
+		}
+
+		if scheme.Description != "" {
+			nodes = append(nodes, c.markdownNodes(scheme.Description)...)
+		}
+	}
+
+	return nodes
+}
+
 func (c *ADFConverter) serverList(servers []domain.Server) adfNode {
 	items := make([]adfNode, 0, len(servers))
 
@@ -351,9 +382,15 @@ func (c *ADFConverter) serverList(servers []domain.Server) adfNode {
 func (c *ADFConverter) operationNodes(pathStr string, operation domain.Operation) []adfNode {
 	nodes := []adfNode{}
 
-	// Endpoint heading with method and path
-	endpointTitle := fmt.Sprintf("%s %s", formatMethod(operation.Method), pathStr)
-	nodes = append(nodes, c.heading(endpointTitle, 5))
+	// Endpoint heading with a method lozenge and the path
+	nodes = append(nodes, adfNode{
+		Type:  "heading",
+		Attrs: &adfAttrs{Level: 5},
+		Content: []adfNode{
+			c.methodStatus(operation.Method),
+			{Type: "text", Text: " " + pathStr},
+		},
+	})
 
 	// Summary (bold)
 	if operation.Summary != "" {
@@ -367,19 +404,30 @@ func (c *ADFConverter) operationNodes(pathStr string, operation domain.Operation
 
 	// Description
 	if operation.Description != "" {
-		nodes = append(nodes, c.paragraph(operation.Description))
+		nodes = append(nodes, c.panel("info", c.markdownNodes(operation.Description)))
 	}
 
 	// Parameters
 	if len(operation.Parameters) > 0 {
 		nodes = append(nodes, c.heading("Parameters", 6))
-		nodes = append(nodes, c.parameterList(operation.Parameters))
+		nodes = append(nodes, c.parameterTable(operation.Parameters))
+	}
+
+	// Request body
+	if operation.RequestBody != nil {
+		nodes = append(nodes, c.heading("Request Body", 6))
+		nodes = append(nodes, c.requestBodyNodes(operation.RequestBody)...)
 	}
 
 	// Responses
 	if len(operation.Responses) > 0 {
 		nodes = append(nodes, c.heading("Responses", 6))
-		nodes = append(nodes, c.responseList(operation.Responses))
+		nodes = append(nodes, c.responseTable(operation.Responses))
+	}
+
+	if examples := responseExamples(operation.Responses, c.synth); len(examples) > 0 {
+		nodes = append(nodes, c.heading("Response Examples", 6))
+		nodes = append(nodes, c.exampleNodes(examples)...)
 	}
 
 	// Divider between endpoints
@@ -388,55 +436,256 @@ func (c *ADFConverter) operationNodes(pathStr string, operation domain.Operation
 	return nodes
 }
 
-func (c *ADFConverter) parameterList(params []domain.Parameter) adfNode {
-	items := make([]adfNode, 0, len(params))
+// requestBodyNodes renders a request body: whether it is required, its
+// description, a table of content types and its examples.
+func (c *ADFConverter) requestBodyNodes(rb *domain.RequestBody) []adfNode {
+	nodes := []adfNode{}
+
+	if rb.Required {
+		nodes = append(nodes, c.panel("note", []adfNode{c.paragraph("This request body is required.")}))
+	}
+
+	if rb.Description != "" {
+		nodes = append(nodes, c.markdownNodes(rb.Description)...)
+	}
+
+	if len(rb.Content) > 0 {
+		rows := make([][]adfNode, 0, len(rb.Content))
+		for _, contentType := range sortedKeys(rb.Content) {
+			typeName, _ := schemaTypeName(rb.Content[contentType].Schema)
+			rows = append(rows, []adfNode{c.codeText(contentType), c.text(typeName)})
+		}
+
+		nodes = append(nodes, c.table([]int{60, 130}, []string{"Content-Type", "Object"}, rows))
+	}
+
+	if examples := requestExamples(rb, c.synth); len(examples) > 0 {
+		nodes = append(nodes, c.heading("Request Examples", 6))
+		nodes = append(nodes, c.exampleNodes(examples)...)
+	}
+
+	return nodes
+}
+
+func (c *ADFConverter) parameterTable(params []domain.Parameter) adfNode {
+	rows := make([][]adfNode, 0, len(params))
 
 	for _, param := range params {
-		required := ""
+		required := "No"
 		if param.Required {
-			required = " (required)"
+			required = "Yes"
 		}
 
-		items = append(items, adfNode{
-			Type: "listItem",
-			Content: []adfNode{
-				{
-					Type: "paragraph",
-					Content: []adfNode{
-						c.codeText(param.Name),
-						{Type: "text", Text: fmt.Sprintf(" (%s): %s%s", param.In, param.Description, required)},
-					},
-				},
-			},
+		schemaType := param.Schema.Type
+		if param.Schema.Format != "" {
+			schemaType = fmt.Sprintf("%s (%s)", param.Schema.Type, param.Schema.Format)
+		}
+		if param.Schema.Ref != "" {
+			schemaType = extractRefName(param.Schema.Ref)
+		}
+
+		rows = append(rows, []adfNode{
+			c.codeText(param.Name),
+			c.text(param.In),
+			c.text(required),
+			c.text(schemaType),
+			c.text(markdownToText(param.Description)),
+		})
+	}
+
+	return c.table([]int{35, 20, 15, 60, 60}, []string{"Name", "In", "Required", "Type", "Description"}, rows)
+}
+
+func (c *ADFConverter) responseTable(responses []domain.Response) adfNode {
+	sorted := make([]domain.Response, len(responses))
+	copy(sorted, responses)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].StatusCode < sorted[j].StatusCode
+	})
+
+	rows := make([][]adfNode, 0, len(sorted))
+
+	for _, resp := range sorted {
+		object := ""
+		if contentTypes := sortedKeys(resp.Content); len(contentTypes) > 0 {
+			object, _ = schemaTypeName(resp.Content[contentTypes[0]].Schema)
+		}
+
+		rows = append(rows, []adfNode{
+			c.codeText(resp.StatusCode),
+			c.text(markdownToText(resp.Description)),
+			c.text(object),
 		})
 	}
 
+	return c.table([]int{25, 95, 70}, []string{"Status", "Description", "Object"}, rows)
+}
+
+// table builds a table with a header row. Widths are relative and scaled to
+// the default table width; each row holds one inline node per cell, and
+// empty text nodes leave their cell blank.
+func (c *ADFConverter) table(widths []int, headers []string, rows [][]adfNode) adfNode {
+	total := 0
+	for _, w := range widths {
+		total += w
+	}
+
+	colwidths := make([]int, len(widths))
+	for i, w := range widths {
+		colwidths[i] = w * adfTableWidth / total
+	}
+
+	cell := func(cellType string, col int, inline adfNode) adfNode {
+		para := adfNode{Type: "paragraph"}
+		if inline.Type != "text" || inline.Text != "" {
+			para.Content = []adfNode{inline}
+		}
+
+		return adfNode{
+			Type:    cellType,
+			Attrs:   &adfAttrs{Colwidth: []int{colwidths[col]}},
+			Content: []adfNode{para},
+		}
+	}
+
+	header := adfNode{Type: "tableRow"}
+	for i, h := range headers {
+		header.Content = append(header.Content, cell("tableHeader", i, c.boldText(h)))
+	}
+
+	table := adfNode{
+		Type:    "table",
+		Attrs:   &adfAttrs{Layout: "default"},
+		Content: []adfNode{header},
+	}
+
+	for _, cells := range rows {
+		row := adfNode{Type: "tableRow"}
+		for i, inline := range cells {
+			row.Content = append(row.Content, cell("tableCell", i, inline))
+		}
+		table.Content = append(table.Content, row)
+	}
+
+	return table
+}
+
+// panel wraps block nodes in a panel of the given type (info, note, warning,
+// success or error). Panels cannot hold block quotes, so their content is
+// lifted out.
+func (c *ADFConverter) panel(panelType string, content []adfNode) adfNode {
+	blocks := make([]adfNode, 0, len(content))
+	for _, node := range content {
+		if node.Type == "blockquote" {
+			blocks = append(blocks, node.Content...)
+			continue
+		}
+		blocks = append(blocks, node)
+	}
+
 	return adfNode{
-		Type:    "bulletList",
-		Content: items,
+		Type:    "panel",
+		Attrs:   &adfAttrs{PanelType: panelType},
+		Content: blocks,
 	}
 }
 
-func (c *ADFConverter) responseList(responses []domain.Response) adfNode {
-	items := make([]adfNode, 0, len(responses))
+// methodStatus renders an HTTP method as a colored status lozenge.
+func (c *ADFConverter) methodStatus(method string) adfNode {
+	method = formatMethod(method)
 
-	for _, resp := range responses {
-		items = append(items, adfNode{
-			Type: "listItem",
-			Content: []adfNode{
-				{
-					Type: "paragraph",
-					Content: []adfNode{
-						c.codeText(resp.StatusCode),
-						{Type: "text", Text: fmt.Sprintf(": %s", resp.Description)},
-					},
-				},
-			},
-		})
+	color, ok := adfMethodColors[method]
+	if !ok {
+		color = "neutral"
 	}
 
 	return adfNode{
-		Type:    "bulletList",
-		Content: items,
+		Type:  "status",
+		Attrs: &adfAttrs{Text: method, Color: color},
+	}
+}
+
+// markdownNodes converts a CommonMark description into ADF block nodes.
+func (c *ADFConverter) markdownNodes(src string) []adfNode {
+	return c.markdownBlockNodes(parseMarkdown(src))
+}
+
+func (c *ADFConverter) markdownBlockNodes(blocks []mdBlock) []adfNode {
+	nodes := make([]adfNode, 0, len(blocks))
+
+	for _, block := range blocks {
+		switch block.Type {
+		case mdParagraph:
+			nodes = append(nodes, adfNode{Type: "paragraph", Content: c.inlineNodes(block.Inlines)})
+
+		case mdHeading:
+			inlines := make([]mdInline, len(block.Inlines))
+			for i, in := range block.Inlines {
+				in.Bold = true
+				inlines[i] = in
+			}
+			nodes = append(nodes, adfNode{Type: "paragraph", Content: c.inlineNodes(inlines)})
+
+		case mdBulletList, mdOrderedList:
+			list := adfNode{Type: "bulletList"}
+			if block.Type == mdOrderedList {
+				list.Type = "orderedList"
+				list.Attrs = &adfAttrs{Order: block.Start}
+			}
+			for _, item := range block.Items {
+				content := c.markdownBlockNodes(item)
+				if len(content) == 0 {
+					content = []adfNode{{Type: "paragraph"}}
+				}
+				list.Content = append(list.Content, adfNode{Type: "listItem", Content: content})
+			}
+			nodes = append(nodes, list)
+
+		case mdCodeBlock:
+			node := adfNode{Type: "codeBlock"}
+			if block.Lang != "" {
+				node.Attrs = &adfAttrs{Language: block.Lang}
+			}
+			if block.Code != "" {
+				node.Content = []adfNode{{Type: "text", Text: block.Code}}
+			}
+			nodes = append(nodes, node)
+
+		case mdQuote:
+			nodes = append(nodes, adfNode{Type: "blockquote", Content: c.markdownBlockNodes(block.Blocks)})
+
+		case mdRule:
+			nodes = append(nodes, adfNode{Type: "rule"})
+		}
+	}
+
+	return nodes
+}
+
+// inlineNodes converts styled runs into ADF text nodes with marks.
+func (c *ADFConverter) inlineNodes(inlines []mdInline) []adfNode {
+	nodes := make([]adfNode, 0, len(inlines))
+
+	for _, in := range inlines {
+		node := adfNode{Type: "text", Text: in.Text}
+
+		// ADF only allows the code mark alongside link
+		if in.Code {
+			node.Marks = append(node.Marks, adfMark{Type: "code"})
+		} else {
+			if in.Bold {
+				node.Marks = append(node.Marks, adfMark{Type: "strong"})
+			}
+			if in.Italic {
+				node.Marks = append(node.Marks, adfMark{Type: "em"})
+			}
+		}
+		if in.Link != "" {
+			node.Marks = append(node.Marks, adfMark{Type: "link", Attrs: map[string]any{"href": in.Link}})
+		}
+
+		nodes = append(nodes, node)
 	}
+
+	return nodes
 }
@@ -0,0 +1,65 @@
+package converters_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/santhosh-tekuri/jsonschema/v5"
+
+	"github.com/GabrielNunesIT/openapi-converter/internal/adapters/converters"
+	"github.com/GabrielNunesIT/openapi-converter/internal/adapters/loaders"
+)
+
+const adfSchemaFile = "testdata/adf_schema.json"
+
+func TestADFConverterMatchesSchema(t *testing.T) {
+	schemaFile, err := os.Open(adfSchemaFile)
+	if err != nil {
+		t.Fatalf("failed to open schema: %v", err)
+	}
+	defer schemaFile.Close()
+
+	compiler := jsonschema.NewCompiler()
+	compiler.Draft = jsonschema.Draft7
+	if err := compiler.AddResource(adfSchemaFile, schemaFile); err != nil {
+		t.Fatalf("failed to add schema: %v", err)
+	}
+	schema, err := compiler.Compile(adfSchemaFile)
+	if err != nil {
+		t.Fatalf("failed to compile schema: %v", err)
+	}
+
+	doc, _, err := loaders.NewOpenAPILoader().Load(context.Background(), "testdata/petstore.yaml")
+	if err != nil {
+		t.Fatalf("failed to load spec: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		opts []converters.ADFOption
+	}{
+		{name: "default"},
+		{name: "generated examples", opts: []converters.ADFOption{converters.WithADFGeneratedExamples(true)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := converters.NewADFConverter(tt.opts...).Convert(doc, &buf); err != nil {
+				t.Fatalf("Convert() error = %v", err)
+			}
+
+			var adf any
+			if err := json.Unmarshal(buf.Bytes(), &adf); err != nil {
+				t.Fatalf("output is not JSON: %v", err)
+			}
+
+			if err := schema.Validate(adf); err != nil {
+				t.Errorf("output does not match the ADF schema: %#v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,661 @@
+package converters
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/GabrielNunesIT/openapi-converter/internal/domain"
+)
+
+const (
+	asciidocFormat    = "asciidoc"
+	asciidocTOCLevels = 3
+)
+
+// AsciiDocConverter converts OpenAPI documents to AsciiDoc, suitable for
+// Asciidoctor and Antora, mirroring the structure of the DOCX output.
+type AsciiDocConverter struct {
+	generateExamples bool
+
+	// Per-conversion state
+	conv       *conversion
+	synth      *exampleSynthesizer
+	body       strings.Builder
+	anchors    map[string]string // Map "kind:tag:name" keys to element IDs
+	ids        map[string]bool
+	currentTag layoutTag
+}
+
+// AsciiDocOption configures an AsciiDocConverter.
+type AsciiDocOption func(*AsciiDocConverter)
+
+// WithAsciiDocGeneratedExamples enables synthesizing examples from schemas
+// for request and response bodies that have none.
+func WithAsciiDocGeneratedExamples(enabled bool) AsciiDocOption {
+	return func(c *AsciiDocConverter) {
+		c.generateExamples = enabled
+	}
+}
+
+// NewAsciiDocConverter creates a new AsciiDoc converter.
+func NewAsciiDocConverter(opts ...AsciiDocOption) *AsciiDocConverter {
+	c := &AsciiDocConverter{}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+// Format returns the output format name.
+func (c *AsciiDocConverter) Format() string {
+	return asciidocFormat
+}
+
+// Convert transforms an OpenAPI document to AsciiDoc format.
+func (c *AsciiDocConverter) Convert(doc *domain.OpenAPIDocument, output io.Writer) error {
+	_, err := c.ConvertContext(context.Background(), doc, output, domain.ConvertOptions{})
+
+	return err
+}
+
+// ConvertContext implements domain.ContextConverter.
+func (c *AsciiDocConverter) ConvertContext(ctx context.Context, doc *domain.OpenAPIDocument, output io.Writer,
+	opts domain.ConvertOptions,
+) ([]domain.Warning, error) {
+	c.body.Reset()
+	c.anchors = make(map[string]string)
+	c.ids = make(map[string]bool)
+	c.currentTag = layoutTag{}
+	c.synth = nil
+	if c.generateExamples {
+		c.synth = newExampleSynthesizer(doc.Components)
+	}
+
+	c.addHeader(doc)
+
+	layout := newDocumentLayout(doc)
+	c.conv = newConversion(ctx, layout, opts)
+
+	for _, section := range layout.sections {
+		if !c.conv.startSection(section) {
+			break
+		}
+
+		switch section.kind {
+		case sectionDescription:
+			c.addDescription(section, doc)
+		case sectionAuthentication:
+			c.addSecurity(section, doc)
+		case sectionServers:
+			c.addServers(section, doc)
+		case sectionEndpoints:
+			c.addPaths(section, layout)
+		}
+	}
+
+	if err := c.conv.finish(); err != nil {
+		return c.conv.warnings, err
+	}
+
+	if _, err := io.WriteString(output, c.body.String()); err != nil {
+		return c.conv.warnings, fmt.Errorf("failed to write document: %w", err)
+	}
+
+	return c.conv.warnings, nil
+}
+
+// addHeader writes the document title and the attributes carrying the API
+// version and table of contents settings.
+func (c *AsciiDocConverter) addHeader(doc *domain.OpenAPIDocument) {
+	fmt.Fprintf(&c.body, "= %s\n", asciidocText(doc.Title))
+	if doc.Version != "" {
+		fmt.Fprintf(&c.body, ":revnumber: %s\n", asciidocText(doc.Version))
+		fmt.Fprintf(&c.body, ":api-version: %s\n", asciidocText(doc.Version))
+	}
+	c.body.WriteString(":version-label: Version\n")
+	c.body.WriteString(":toc:\n")
+	fmt.Fprintf(&c.body, ":toclevels: %d\n", asciidocTOCLevels)
+	c.body.WriteString(":sectanchors:\n")
+}
+
+// anchor returns the element ID for a key, allocating one on first use so
+// cross-references can be written before their target.
+func (c *AsciiDocConverter) anchor(key string) string {
+	if id, ok := c.anchors[key]; ok {
+		return id
+	}
+
+	base := asciidocID(key)
+	id := base
+	for n := 2; c.ids[id]; n++ {
+		id = fmt.Sprintf("%s-%d", base, n)
+	}
+
+	c.anchors[key] = id
+	c.ids[id] = true
+
+	return id
+}
+
+// xref returns a cross-reference to the element with the given key.
+func (c *AsciiDocConverter) xref(key, text string) string {
+	return fmt.Sprintf("<<%s,%s>>", c.anchor(key), asciidocText(text))
+}
+
+// linkText renders a link as a cross-reference, or as plain text when it has
+// no target.
+func (c *AsciiDocConverter) linkText(link layoutLink) string {
+	if link.key == "" {
+		return asciidocText(link.text)
+	}
+
+	return c.xref(link.key, link.text)
+}
+
+var asciidocIDPattern = regexp.MustCompile(`[^a-z0-9]+`)
+
+// asciidocID derives a readable element ID from an anchor key.
+func asciidocID(key string) string {
+	id := strings.Trim(asciidocIDPattern.ReplaceAllString(strings.ToLower(key), "-"), "-")
+	if id == "" || (id[0] >= '0' && id[0] <= '9') {
+		id = "_" + id
+	}
+
+	return id
+}
+
+// addHeading adds a section title. Level 1 is the first level below the
+// document title; key gives the section an ID others can link to.
+func (c *AsciiDocConverter) addHeading(text string, level int, key string) {
+	c.body.WriteString("\n")
+	if key != "" {
+		fmt.Fprintf(&c.body, "[#%s]\n", c.anchor(key))
+	}
+	fmt.Fprintf(&c.body, "%s %s\n", strings.Repeat("=", level+1), asciidocText(text))
+}
+
+// addParagraph adds a block of already-escaped text.
+func (c *AsciiDocConverter) addParagraph(content string) {
+	fmt.Fprintf(&c.body, "\n%s\n", asciidocBlockText(content))
+}
+
+func (c *AsciiDocConverter) addDescription(section layoutSection, doc *domain.OpenAPIDocument) {
+	c.addHeading(section.title, 1, "")
+	c.addMarkdown(doc.Description)
+}
+
+func (c *AsciiDocConverter) addSecurity(section layoutSection, doc *domain.OpenAPIDocument) {
+	c.addHeading(section.title, 1, "")
+
+	for _, name := range sortedKeys(doc.SecuritySchemes) {
+		scheme := doc.SecuritySchemes[name]
+
+		c.addHeading(name, 2, "")
+
+		var fields []string
+		fields = append(fields, asciidocField("Type", scheme.Type))
+
+		if scheme.In != "" {
+			fields = append(fields, asciidocField("In", scheme.In))
+		}
+		if scheme.Name != "" && scheme.Name != name {
+			fields = append(fields, asciidocField("Name", scheme.Name))
+		}
+		if scheme.Scheme != "" {
+			fields = append(fields, asciidocField("Scheme", scheme.Scheme))
+		}
+
+		c.addParagraph(strings.Join(fields, " +\n"))
+
+		if scheme.Description != "" {
+			c.addMarkdown(scheme.Description)
+		}
+	}
+}
+
+// asciidocField formats a "Label: value" line with a bold label.
+func asciidocField(label, value string) string {
+	return fmt.Sprintf("**%s:** %s", asciidocText(label), asciidocText(value))
+}
+
+func (c *AsciiDocConverter) addServers(section layoutSection, doc *domain.OpenAPIDocument) {
+	c.addHeading(section.title, 1, "")
+
+	c.body.WriteString("\n")
+	for _, server := range doc.Servers {
+		text := server.URL
+		if server.Description != "" {
+			text = fmt.Sprintf("%s - %s", server.URL, server.Description)
+		}
+
+		fmt.Fprintf(&c.body, "* %s\n", asciidocText(text))
+	}
+}
+
+func (c *AsciiDocConverter) addPaths(section layoutSection, layout *documentLayout) {
+	c.addHeading(section.title, 1, "")
+
+	for _, tag := range layout.tags {
+		c.currentTag = tag
+		c.addHeading(tag.name, 2, tag.key)
+
+		if tag.description != "" {
+			c.addMarkdown(tag.description)
+		}
+
+		c.addEndpointsSummary(tag)
+
+		if len(tag.components) > 0 {
+			c.addTagComponents(tag.components)
+		}
+
+		for _, ep := range tag.endpoints {
+			if !c.conv.startEndpoint(ep) {
+				return
+			}
+
+			c.addOperation(ep.path, ep.operation)
+		}
+	}
+}
+
+// addEndpointsSummary renders the endpoints table of a tag as a titled table.
+func (c *AsciiDocConverter) addEndpointsSummary(tag layoutTag) {
+	if len(tag.endpoints) == 0 {
+		return
+	}
+
+	rows := make([][]string, 0, len(tag.endpoints))
+	for _, row := range tag.summaryRows() {
+		rows = append(rows, []string{c.linkText(row.summary), c.linkText(row.path), asciidocText(formatMethod(row.method))})
+	}
+
+	c.addTable(endpointsSummaryTitle, endpointsSummaryWidths, endpointsSummaryHeaders, rows)
+}
+
+// addTable adds a titled table with a header row. Widths are relative column
+// proportions; cells hold already-escaped text.
+func (c *AsciiDocConverter) addTable(title string, widths []float64, headers []string, rows [][]string) {
+	cols := make([]string, len(widths))
+	for i, w := range widths {
+		cols[i] = fmt.Sprint(w)
+	}
+
+	c.body.WriteString("\n")
+	if title != "" {
+		fmt.Fprintf(&c.body, ".%s\n", asciidocText(title))
+	}
+	fmt.Fprintf(&c.body, "[%%header,cols=\"%s\"]\n", strings.Join(cols, ","))
+	c.body.WriteString("|===\n")
+
+	for _, header := range headers {
+		fmt.Fprintf(&c.body, "|%s ", asciidocText(header))
+	}
+	c.body.WriteString("\n")
+
+	for _, cells := range rows {
+		c.body.WriteString("\n")
+		for _, cell := range cells {
+			fmt.Fprintf(&c.body, "|%s\n", cell)
+		}
+	}
+
+	c.body.WriteString("|===\n")
+}
+
+// addTagComponents renders the component schemas used by endpoints in a tag.
+func (c *AsciiDocConverter) addTagComponents(components []layoutComponent) {
+	c.addHeading("Schemas Used", 3, "")
+
+	for _, component := range components {
+		c.addComponentSchema(component.name, component.schema)
+	}
+}
+
+// addComponentSchema renders a single component schema.
+func (c *AsciiDocConverter) addComponentSchema(name string, schema domain.Schema) {
+	c.addHeading(name, 4, componentKey(c.currentTag.name, name))
+
+	if schema.Type != "" {
+		typeStr := schema.Type
+		if schema.Format != "" {
+			typeStr = fmt.Sprintf("%s (%s)", schema.Type, schema.Format)
+		}
+		c.addParagraph(asciidocField("Type", typeStr))
+	}
+
+	if schema.Description != "" {
+		c.addMarkdown(schema.Description)
+	}
+
+	if len(schema.Properties) > 0 {
+		rows := make([][]string, 0, len(schema.Properties))
+
+		for _, propName := range sortedKeys(schema.Properties) {
+			prop := schema.Properties[propName]
+
+			propType := asciidocText(prop.Type)
+			if prop.Ref != "" {
+				refName := extractRefName(prop.Ref)
+				propType = c.linkText(c.currentTag.componentLink(refName, refName))
+			} else if prop.Format != "" {
+				propType = asciidocText(fmt.Sprintf("%s (%s)", prop.Type, prop.Format))
+			}
+
+			rows = append(rows, []string{asciidocText(propName), propType, asciidocText(markdownToText(prop.Description))})
+		}
+
+		c.addTable("", []float64{50, 50, 90}, []string{"Name", "Type", "Description"}, rows)
+	}
+}
+
+func (c *AsciiDocConverter) addOperation(pathStr string, op domain.Operation) {
+	c.addHeading(fmt.Sprintf("%s %s", formatMethod(op.Method), pathStr), 3,
+		endpointKey(c.currentTag.name, op.Method, pathStr))
+
+	if op.Summary != "" {
+		c.addParagraph(asciidocText(op.Summary))
+	}
+
+	if op.Description != "" {
+		c.addMarkdown(op.Description)
+	}
+
+	if len(op.Parameters) > 0 {
+		c.addHeading("Parameters", 4, "")
+		c.addParameterTable(op.Parameters)
+	}
+
+	if op.RequestBody != nil {
+		c.addHeading("Request Body", 4, "")
+		c.addRequestBody(op.RequestBody)
+	}
+
+	if len(op.Responses) > 0 {
+		c.addHeading("Responses", 4, "")
+		c.addResponseTable(op.Responses)
+	}
+
+	if examples := responseExamples(op.Responses, c.synth); len(examples) > 0 {
+		c.addHeading("Response Examples", 4, "")
+		for _, ex := range examples {
+			c.addExample(ex)
+		}
+	}
+}
+
+func (c *AsciiDocConverter) addParameterTable(params []domain.Parameter) {
+	rows := make([][]string, 0, len(params))
+
+	for _, param := range params {
+		required := "No"
+		if param.Required {
+			required = "Yes"
+		}
+
+		schemaType := asciidocText(param.Schema.Type)
+		if param.Schema.Format != "" {
+			schemaType = asciidocText(fmt.Sprintf("%s (%s)", param.Schema.Type, param.Schema.Format))
+		}
+		if param.Schema.Ref != "" {
+			refName := extractRefName(param.Schema.Ref)
+			schemaType = c.linkText(c.currentTag.componentLink(refName, refName))
+		}
+
+		rows = append(rows, []string{
+			asciidocText(param.Name), asciidocText(param.In), asciidocText(required), schemaType,
+			asciidocText(markdownToText(param.Description)),
+		})
+	}
+
+	c.addTable("", []float64{35, 20, 15, 60, 60}, []string{"Name", "In", "Required", "Type", "Description"}, rows)
+}
+
+func (c *AsciiDocConverter) addResponseTable(responses []domain.Response) {
+	sorted := make([]domain.Response, len(responses))
+	copy(sorted, responses)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].StatusCode < sorted[j].StatusCode
+	})
+
+	rows := make([][]string, 0, len(sorted))
+
+	for _, resp := range sorted {
+		object := ""
+		if contentTypes := sortedKeys(resp.Content); len(contentTypes) > 0 {
+			object = c.linkText(c.currentTag.componentLink(schemaTypeName(resp.Content[contentTypes[0]].Schema)))
+		}
+
+		rows = append(rows, []string{asciidocText(resp.StatusCode), asciidocText(markdownToText(resp.Description)), object})
+	}
+
+	c.addTable("", []float64{25, 95, 70}, []string{"Status", "Description", "Object"}, rows)
+}
+
+func (c *AsciiDocConverter) addRequestBody(rb *domain.RequestBody) {
+	if rb.Required {
+		c.addParagraph("__Required__")
+	}
+
+	if rb.Description != "" {
+		c.addMarkdown(rb.Description)
+	}
+
+	if len(rb.Content) == 0 {
+		return
+	}
+
+	rows := make([][]string, 0, len(rb.Content))
+	for _, contentType := range sortedKeys(rb.Content) {
+		object := c.currentTag.componentLink(schemaTypeName(rb.Content[contentType].Schema))
+		rows = append(rows, []string{asciidocText(contentType), c.linkText(object)})
+	}
+
+	c.addTable("", []float64{60, 130}, []string{"Content-Type", "Object"}, rows)
+
+	if examples := requestExamples(rb, c.synth); len(examples) > 0 {
+		c.addHeading("Request Examples", 4, "")
+		for _, ex := range examples {
+			c.addExample(ex)
+		}
+	}
+}
+
+// addExample renders a formatted example as a titled, line-numbered source
+// block; highlighting is left to the Asciidoctor source highlighter.
+func (c *AsciiDocConverter) addExample(ex namedExample) {
+	code, lang := formatExample(ex.mediaType, ex.value)
+
+	c.body.WriteString("\n")
+	fmt.Fprintf(&c.body, ".%s\n", asciidocText(ex.title))
+	if lang != exampleForm && lang != exampleText {
+		fmt.Fprintf(&c.body, "[source%%linenums,%s]\n", lang)
+	} else {
+		c.body.WriteString("[source%linenums]\n")
+	}
+	c.body.WriteString(asciidocDelimited(code, '-'))
+}
+
+// asciidocDelimited wraps content in a delimited block, lengthening the
+// delimiter when a line of the content would close the block early.
+func asciidocDelimited(content string, ch byte) string {
+	delim := strings.Repeat(string(ch), 4)
+	for strings.Contains("\n"+content+"\n", "\n"+delim+"\n") {
+		delim += string(ch)
+	}
+
+	return delim + "\n" + content + "\n" + delim + "\n"
+}
+
+// addMarkdown renders a CommonMark description as AsciiDoc blocks.
+func (c *AsciiDocConverter) addMarkdown(src string) {
+	for _, block := range asciidocBlocks(parseMarkdown(src), 0) {
+		fmt.Fprintf(&c.body, "\n%s", block)
+	}
+}
+
+// asciidocBlocks renders markdown blocks, one string per AsciiDoc block.
+// Depth is the nesting level of lists the blocks appear in.
+func asciidocBlocks(blocks []mdBlock, depth int) []string {
+	out := make([]string, 0, len(blocks))
+
+	for _, block := range blocks {
+		switch block.Type {
+		case mdParagraph:
+			out = append(out, asciidocBlockText(asciidocInlines(block.Inlines))+"\n")
+
+		case mdHeading:
+			out = append(out, "[discrete]\n===== "+asciidocInlines(block.Inlines)+"\n")
+
+		case mdBulletList, mdOrderedList:
+			out = append(out, asciidocList(block, depth))
+
+		case mdCodeBlock:
+			attrs := "[source]\n"
+			if block.Lang != "" {
+				attrs = fmt.Sprintf("[source,%s]\n", block.Lang)
+			}
+			out = append(out, attrs+asciidocDelimited(block.Code, '-'))
+
+		case mdQuote:
+			out = append(out, asciidocDelimited(strings.TrimSuffix(strings.Join(asciidocBlocks(block.Blocks, 0), "\n"), "\n"), '_'))
+
+		case mdRule:
+			out = append(out, "'''\n")
+		}
+	}
+
+	return out
+}
+
+// asciidocList renders a list. Blocks after the first of an item are attached
+// with list continuations; nested lists use deeper markers instead.
+func asciidocList(list mdBlock, depth int) string {
+	var b strings.Builder
+
+	marker := strings.Repeat("*", depth+1)
+	if list.Type == mdOrderedList {
+		marker = strings.Repeat(".", depth+1)
+		if list.Start > 1 {
+			fmt.Fprintf(&b, "[start=%d]\n", list.Start)
+		}
+	}
+
+	for _, item := range list.Items {
+		b.WriteString(marker + " ")
+
+		rest := item
+		if len(item) > 0 && item[0].Type == mdParagraph {
+			b.WriteString(asciidocInlines(item[0].Inlines))
+			rest = item[1:]
+		} else {
+			b.WriteString("{empty}")
+		}
+		b.WriteString("\n")
+
+		for _, block := range rest {
+			if block.Type == mdBulletList || block.Type == mdOrderedList {
+				b.WriteString(asciidocList(block, depth+1))
+				continue
+			}
+			for _, rendered := range asciidocBlocks([]mdBlock{block}, depth+1) {
+				b.WriteString("+\n" + rendered)
+			}
+		}
+	}
+
+	return b.String()
+}
+
+// asciidocInlines renders styled text runs with unconstrained formatting
+// marks, which apply regardless of the surrounding characters.
+func asciidocInlines(inlines []mdInline) string {
+	var b strings.Builder
+
+	for _, in := range inlines {
+		text := asciidocText(in.Text)
+		if in.Code {
+			text = "``" + text + "``"
+		}
+		if in.Italic {
+			text = "__" + text + "__"
+		}
+		if in.Bold {
+			text = "**" + text + "**"
+		}
+		if in.Link != "" {
+			text = fmt.Sprintf("link:%s[%s]", asciidocURL(in.Link), text)
+		}
+
+		b.WriteString(text)
+	}
+
+	return b.String()
+}
+
+// asciidocURL makes a link target safe for use in a link macro.
+func asciidocURL(target string) string {
+	return strings.NewReplacer(" ", "%20", "[", "%5B", "]", "%5D").Replace(target)
+}
+
+// asciidocEscapes are characters that start inline markup, attribute
+// references, macros or table cells. They are written as character
+// references, which Asciidoctor resolves after applying markup.
+var asciidocEscapes = map[rune]bool{
+	'*': true, '`': true, '#': true, '^': true, '~': true, '+': true,
+	'[': true, ']': true, '{': true, '}': true, '|': true, '<': true, '>': true,
+}
+
+// asciidocLineStarts are characters that start a block when they begin a line.
+const asciidocLineStarts = "=.-/:'"
+
+// asciidocText escapes plain text so it renders literally. Underscores only
+// need escaping outside words, where they could delimit emphasis. Line breaks
+// become hard breaks.
+func asciidocText(s string) string {
+	lines := strings.Split(s, "\n")
+
+	for i, line := range lines {
+		var b strings.Builder
+		runes := []rune(line)
+		for j, r := range runes {
+			escape := asciidocEscapes[r]
+			if r == '_' && !escape {
+				escape = j == 0 || j+1 == len(runes) || !isWordRune(runes[j-1]) || !isWordRune(runes[j+1])
+			}
+
+			if escape {
+				fmt.Fprintf(&b, "&#%d;", r)
+			} else {
+				b.WriteRune(r)
+			}
+		}
+
+		lines[i] = b.String()
+		if i > 0 {
+			lines[i] = asciidocBlockText(strings.TrimLeft(lines[i], " \t"))
+		}
+	}
+
+	return strings.Join(lines, " +\n")
+}
+
+// asciidocBlockText escapes the first character of text that begins a line,
+// so it is not taken for block markup such as a title, list or comment.
+func asciidocBlockText(text string) string {
+	if text != "" && strings.IndexByte(asciidocLineStarts, text[0]) >= 0 {
+		return fmt.Sprintf("&#%d;", text[0]) + text[1:]
+	}
+
+	return text
+}
+
+func isWordRune(r rune) bool {
+	return r >= 0x80 || isWordByte(byte(r))
+}
@@ -13,6 +13,35 @@ func formatMethod(method string) string {
 	return strings.ToUpper(method)
 }
 
+// schemaTypeName returns the display name of a body schema together with the
+// component it references, if any. Arrays of components render as "[]Name".
+func schemaTypeName(schema domain.Schema) (string, string) {
+	if schema.Ref != "" {
+		refName := extractRefName(schema.Ref)
+		return refName, refName
+	}
+
+	name := schema.Type
+	if schema.Format != "" {
+		name = fmt.Sprintf("%s (%s)", name, schema.Format)
+	}
+
+	if schema.Type == "array" && schema.Items != nil {
+		if schema.Items.Ref != "" {
+			refName := extractRefName(schema.Items.Ref)
+			return "[]" + refName, refName
+		}
+
+		return "[]" + schema.Items.Type, ""
+	}
+
+	if name == "" {
+		name = "Object"
+	}
+
+	return name, ""
+}
+
 // formatParameters returns a formatted parameter list.
 func formatParameters(params []domain.Parameter) string {
 	if len(params) == 0 {
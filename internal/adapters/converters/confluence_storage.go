@@ -0,0 +1,626 @@
+package converters
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/GabrielNunesIT/openapi-converter/internal/domain"
+)
+
+const (
+	storageFormat    = "confluence-storage"
+	storageTOCLevels = 3
+
+	// storageExpandThreshold is the number of properties above which a schema
+	// table is collapsed into an expand macro.
+	storageExpandThreshold = 8
+)
+
+// storageMethodColours maps HTTP methods to status macro colours.
+var storageMethodColours = map[string]string{
+	"GET":    "Green",
+	"POST":   "Blue",
+	"PUT":    "Yellow",
+	"PATCH":  "Yellow",
+	"DELETE": "Red",
+}
+
+// storageCodeLanguages maps example and code block languages to the names
+// accepted by the Server and Data Center code macro.
+var storageCodeLanguages = map[string]string{
+	"bash": "bash", "sh": "bash", "shell": "bash",
+	"c#": "c#", "csharp": "c#", "cpp": "cpp", "c++": "cpp",
+	"css": "css", "diff": "diff", "groovy": "groovy", "java": "java",
+	"javascript": "js", "js": "js", "json": "js", "typescript": "js", "ts": "js",
+	"php": "php", "perl": "perl", "powershell": "powershell", "python": "py", "py": "py",
+	"ruby": "ruby", "sql": "sql", "scala": "scala", "html": "xml", "xml": "xml",
+	"yaml": "yml", "yml": "yml",
+}
+
+// ConfluenceStorageConverter converts OpenAPI documents to the Confluence
+// storage format (XHTML with Confluence macros) used by Server and Data
+// Center, mirroring the structure of the DOCX output. The page title is
+// set when uploading, so the document starts with the API version.
+type ConfluenceStorageConverter struct {
+	generateExamples bool
+
+	// Per-conversion state
+	conv       *conversion
+	synth      *exampleSynthesizer
+	body       strings.Builder
+	anchors    map[string]string // Map "kind:tag:name" keys to anchor names
+	currentTag layoutTag
+}
+
+// ConfluenceStorageOption configures a ConfluenceStorageConverter.
+type ConfluenceStorageOption func(*ConfluenceStorageConverter)
+
+// WithConfluenceStorageGeneratedExamples enables synthesizing examples from
+// schemas for request and response bodies that have none.
+func WithConfluenceStorageGeneratedExamples(enabled bool) ConfluenceStorageOption {
+	return func(c *ConfluenceStorageConverter) {
+		c.generateExamples = enabled
+	}
+}
+
+// NewConfluenceStorageConverter creates a new Confluence storage format converter.
+func NewConfluenceStorageConverter(opts ...ConfluenceStorageOption) *ConfluenceStorageConverter {
+	c := &ConfluenceStorageConverter{}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+// Format returns the output format name.
+func (c *ConfluenceStorageConverter) Format() string {
+	return storageFormat
+}
+
+// Convert transforms an OpenAPI document to Confluence storage format.
+func (c *ConfluenceStorageConverter) Convert(doc *domain.OpenAPIDocument, output io.Writer) error {
+	_, err := c.ConvertContext(context.Background(), doc, output, domain.ConvertOptions{})
+
+	return err
+}
+
+// ConvertContext implements domain.ContextConverter.
+func (c *ConfluenceStorageConverter) ConvertContext(ctx context.Context, doc *domain.OpenAPIDocument, output io.Writer,
+	opts domain.ConvertOptions,
+) ([]domain.Warning, error) {
+	c.body.Reset()
+	c.anchors = make(map[string]string)
+	c.currentTag = layoutTag{}
+	c.synth = nil
+	if c.generateExamples {
+		c.synth = newExampleSynthesizer(doc.Components)
+	}
+
+	c.addParagraph(storageText("Version: " + doc.Version))
+	c.body.WriteString(storageMacro("toc", map[string]string{"maxLevel": fmt.Sprint(storageTOCLevels)}, ""))
+
+	layout := newDocumentLayout(doc)
+	c.conv = newConversion(ctx, layout, opts)
+
+	for _, section := range layout.sections {
+		if !c.conv.startSection(section) {
+			break
+		}
+
+		switch section.kind {
+		case sectionDescription:
+			c.addDescription(section, doc)
+		case sectionAuthentication:
+			c.addSecurity(section, doc)
+		case sectionServers:
+			c.addServers(section, doc)
+		case sectionEndpoints:
+			c.addPaths(section, layout)
+		}
+	}
+
+	if err := c.conv.finish(); err != nil {
+		return c.conv.warnings, err
+	}
+
+	if _, err := io.WriteString(output, c.body.String()); err != nil {
+		return c.conv.warnings, fmt.Errorf("failed to write document: %w", err)
+	}
+
+	return c.conv.warnings, nil
+}
+
+// anchor returns the anchor name for a key, allocating one on first use so
+// links can be created before their target is rendered.
+func (c *ConfluenceStorageConverter) anchor(key string) string {
+	name, ok := c.anchors[key]
+	if !ok {
+		name = fmt.Sprintf("ocRef%d", len(c.anchors)+1)
+		c.anchors[key] = name
+	}
+
+	return name
+}
+
+// link returns a link to the anchor of key with the given text.
+func (c *ConfluenceStorageConverter) link(key, text string) string {
+	return fmt.Sprintf(`<ac:link ac:anchor="%s"><ac:plain-text-link-body>%s</ac:plain-text-link-body></ac:link>`,
+		c.anchor(key), storageCDATA(text))
+}
+
+// linkText renders a link as a link to its anchor macro, or as escaped text
+// when it has no target.
+func (c *ConfluenceStorageConverter) linkText(link layoutLink) string {
+	if link.key == "" {
+		return storageText(link.text)
+	}
+
+	return c.link(link.key, link.text)
+}
+
+// addHeading adds a heading; key places an anchor macro in it so other parts
+// of the page can link to it. Content is already-escaped XHTML.
+func (c *ConfluenceStorageConverter) addHeading(content string, level int, key string) {
+	anchor := ""
+	if key != "" {
+		anchor = storageMacro("anchor", map[string]string{"": c.anchor(key)}, "")
+	}
+
+	fmt.Fprintf(&c.body, "<h%d>%s%s</h%d>", level, anchor, content, level)
+}
+
+func (c *ConfluenceStorageConverter) addParagraph(content string) {
+	fmt.Fprintf(&c.body, "<p>%s</p>", content)
+}
+
+func (c *ConfluenceStorageConverter) addDescription(section layoutSection, doc *domain.OpenAPIDocument) {
+	c.addHeading(section.title, 1, "")
+	c.addMarkdown(doc.Description)
+}
+
+func (c *ConfluenceStorageConverter) addSecurity(section layoutSection, doc *domain.OpenAPIDocument) {
+	c.addHeading(section.title, 1, "")
+
+	for _, name := range sortedKeys(doc.SecuritySchemes) {
+		scheme := doc.SecuritySchemes[name]
+
+		c.addHeading(storageText(name), 3, "")
+		c.addField("Type", scheme.Type)
+
+		if scheme.In != "" {
+			c.addField("In", scheme.In)
+		}
+		if scheme.Name != "" && scheme.Name != name {
+			c.addField("Name", scheme.Name)
+		}
+		if scheme.Scheme != "" {
+			c.addField("Scheme", scheme.Scheme)
+		}
+
+		if scheme.Description != "" {
+			c.addMarkdown(scheme.Description)
+		}
+	}
+}
+
+// addField adds a "Label: value" paragraph with a bold label.
+func (c *ConfluenceStorageConverter) addField(label, value string) {
+	c.addParagraph("<strong>" + storageText(label+":") + "</strong> " + storageText(value))
+}
+
+func (c *ConfluenceStorageConverter) addServers(section layoutSection, doc *domain.OpenAPIDocument) {
+	c.addHeading(section.title, 1, "")
+
+	c.body.WriteString("<ul>")
+	for _, server := range doc.Servers {
+		text := server.URL
+		if server.Description != "" {
+			text = fmt.Sprintf("%s - %s", server.URL, server.Description)
+		}
+
+		fmt.Fprintf(&c.body, "<li>%s</li>", storageText(text))
+	}
+	c.body.WriteString("</ul>")
+}
+
+func (c *ConfluenceStorageConverter) addPaths(section layoutSection, layout *documentLayout) {
+	c.addHeading(section.title, 1, "")
+
+	for _, tag := range layout.tags {
+		c.currentTag = tag
+		c.addHeading(storageText(tag.name), 2, tag.key)
+
+		if tag.description != "" {
+			c.addMarkdown(tag.description)
+		}
+
+		c.addEndpointsSummary(tag)
+
+		if len(tag.components) > 0 {
+			c.addTagComponents(tag.components)
+		}
+
+		for _, ep := range tag.endpoints {
+			if !c.conv.startEndpoint(ep) {
+				return
+			}
+
+			c.addOperation(ep.path, ep.operation)
+		}
+	}
+}
+
+// addEndpointsSummary renders the endpoints table of a tag, with the method
+// of each endpoint as a status macro.
+func (c *ConfluenceStorageConverter) addEndpointsSummary(tag layoutTag) {
+	if len(tag.endpoints) == 0 {
+		return
+	}
+
+	c.addParagraph("<em>" + storageText(endpointsSummaryTitle) + "</em>")
+
+	rows := make([][]string, 0, len(tag.endpoints))
+	for _, row := range tag.summaryRows() {
+		rows = append(rows, []string{c.linkText(row.summary), c.linkText(row.path), storageMethodStatus(row.method)})
+	}
+
+	c.addTable(endpointsSummaryWidths, endpointsSummaryHeaders, rows)
+}
+
+// addTable adds a table with a header row. Widths are relative and turned
+// into column percentages; cells hold already-escaped XHTML.
+func (c *ConfluenceStorageConverter) addTable(widths []float64, headers []string, rows [][]string) {
+	c.body.WriteString(storageTable(widths, headers, rows))
+}
+
+func storageTable(widths []float64, headers []string, rows [][]string) string {
+	var b strings.Builder
+
+	var total float64
+	for _, w := range widths {
+		total += w
+	}
+
+	b.WriteString("<table><colgroup>")
+	for _, w := range widths {
+		fmt.Fprintf(&b, `<col style="width: %.1f%%;" />`, w/total*100)
+	}
+	b.WriteString("</colgroup><tbody><tr>")
+	for _, header := range headers {
+		fmt.Fprintf(&b, "<th>%s</th>", storageText(header))
+	}
+	b.WriteString("</tr>")
+
+	for _, cells := range rows {
+		b.WriteString("<tr>")
+		for _, cell := range cells {
+			fmt.Fprintf(&b, "<td>%s</td>", cell)
+		}
+		b.WriteString("</tr>")
+	}
+
+	b.WriteString("</tbody></table>")
+
+	return b.String()
+}
+
+// addTagComponents renders the component schemas used by endpoints in a tag.
+func (c *ConfluenceStorageConverter) addTagComponents(components []layoutComponent) {
+	c.addHeading("Schemas Used", 3, "")
+
+	for _, component := range components {
+		c.addComponentSchema(component.name, component.schema)
+	}
+}
+
+// addComponentSchema renders a single component schema. Long property tables
+// are collapsed into an expand macro to keep the page scannable.
+func (c *ConfluenceStorageConverter) addComponentSchema(name string, schema domain.Schema) {
+	c.addHeading(storageText(name), 4, componentKey(c.currentTag.name, name))
+
+	if schema.Type != "" {
+		typeStr := schema.Type
+		if schema.Format != "" {
+			typeStr = fmt.Sprintf("%s (%s)", schema.Type, schema.Format)
+		}
+		c.addField("Type", typeStr)
+	}
+
+	if schema.Description != "" {
+		c.addMarkdown(schema.Description)
+	}
+
+	if len(schema.Properties) > 0 {
+		rows := make([][]string, 0, len(schema.Properties))
+
+		for _, propName := range sortedKeys(schema.Properties) {
+			prop := schema.Properties[propName]
+
+			propType := storageText(prop.Type)
+			if prop.Ref != "" {
+				refName := extractRefName(prop.Ref)
+				propType = c.linkText(c.currentTag.componentLink(refName, refName))
+			} else if prop.Format != "" {
+				propType = storageText(fmt.Sprintf("%s (%s)", prop.Type, prop.Format))
+			}
+
+			rows = append(rows, []string{storageText(propName), propType, storageText(markdownToText(prop.Description))})
+		}
+
+		table := storageTable([]float64{50, 50, 90}, []string{"Name", "Type", "Description"}, rows)
+		if len(rows) > storageExpandThreshold {
+			table = storageMacro("expand", map[string]string{"title": fmt.Sprintf("Properties (%d)", len(rows))}, table)
+		}
+
+		c.body.WriteString(table)
+	}
+}
+
+func (c *ConfluenceStorageConverter) addOperation(pathStr string, op domain.Operation) {
+	c.addHeading(storageMethodStatus(op.Method)+" "+storageText(pathStr), 3,
+		endpointKey(c.currentTag.name, op.Method, pathStr))
+
+	if op.Summary != "" {
+		c.addParagraph(storageText(op.Summary))
+	}
+
+	if op.Description != "" {
+		c.addMarkdown(op.Description)
+	}
+
+	if len(op.Parameters) > 0 {
+		c.addHeading("Parameters", 4, "")
+		c.addParameterTable(op.Parameters)
+	}
+
+	if op.RequestBody != nil {
+		c.addHeading("Request Body", 4, "")
+		c.addRequestBody(op.RequestBody)
+	}
+
+	if len(op.Responses) > 0 {
+		c.addHeading("Responses", 4, "")
+		c.addResponseTable(op.Responses)
+	}
+
+	if examples := responseExamples(op.Responses, c.synth); len(examples) > 0 {
+		c.addHeading("Response Examples", 4, "")
+		for _, ex := range examples {
+			c.addExample(ex)
+		}
+	}
+}
+
+func (c *ConfluenceStorageConverter) addParameterTable(params []domain.Parameter) {
+	rows := make([][]string, 0, len(params))
+
+	for _, param := range params {
+		required := "No"
+		if param.Required {
+			required = "Yes"
+		}
+
+		schemaType := storageText(param.Schema.Type)
+		if param.Schema.Format != "" {
+			schemaType = storageText(fmt.Sprintf("%s (%s)", param.Schema.Type, param.Schema.Format))
+		}
+		if param.Schema.Ref != "" {
+			refName := extractRefName(param.Schema.Ref)
+			schemaType = c.linkText(c.currentTag.componentLink(refName, refName))
+		}
+
+		rows = append(rows, []string{
+			"<code>" + storageText(param.Name) + "</code>", storageText(param.In), storageText(required), schemaType,
+			storageText(markdownToText(param.Description)),
+		})
+	}
+
+	c.addTable([]float64{35, 20, 15, 60, 60}, []string{"Name", "In", "Required", "Type", "Description"}, rows)
+}
+
+func (c *ConfluenceStorageConverter) addResponseTable(responses []domain.Response) {
+	sorted := make([]domain.Response, len(responses))
+	copy(sorted, responses)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].StatusCode < sorted[j].StatusCode
+	})
+
+	rows := make([][]string, 0, len(sorted))
+
+	for _, resp := range sorted {
+		object := ""
+		if contentTypes := sortedKeys(resp.Content); len(contentTypes) > 0 {
+			object = c.linkText(c.currentTag.componentLink(schemaTypeName(resp.Content[contentTypes[0]].Schema)))
+		}
+
+		rows = append(rows, []string{storageText(resp.StatusCode), storageText(markdownToText(resp.Description)), object})
+	}
+
+	c.addTable([]float64{25, 95, 70}, []string{"Status", "Description", "Object"}, rows)
+}
+
+func (c *ConfluenceStorageConverter) addRequestBody(rb *domain.RequestBody) {
+	if rb.Required {
+		c.addParagraph("<em>Required</em>")
+	}
+
+	if rb.Description != "" {
+		c.addMarkdown(rb.Description)
+	}
+
+	if len(rb.Content) == 0 {
+		return
+	}
+
+	rows := make([][]string, 0, len(rb.Content))
+	for _, contentType := range sortedKeys(rb.Content) {
+		object := c.currentTag.componentLink(schemaTypeName(rb.Content[contentType].Schema))
+		rows = append(rows, []string{storageText(contentType), c.linkText(object)})
+	}
+
+	c.addTable([]float64{60, 130}, []string{"Content-Type", "Object"}, rows)
+
+	if examples := requestExamples(rb, c.synth); len(examples) > 0 {
+		c.addHeading("Request Examples", 4, "")
+		for _, ex := range examples {
+			c.addExample(ex)
+		}
+	}
+}
+
+// addExample renders a formatted example as a titled code macro with line
+// numbers; highlighting is left to Confluence.
+func (c *ConfluenceStorageConverter) addExample(ex namedExample) {
+	code, lang := formatExample(ex.mediaType, ex.value)
+
+	c.body.WriteString(storageCode(code, lang, ex.title, true))
+}
+
+// storageCode renders a code macro. Languages the macro does not know are
+// shown as plain text rather than with the macro's default highlighting.
+func storageCode(code, lang, title string, lineNumbers bool) string {
+	params := map[string]string{"language": "text"}
+	if name, ok := storageCodeLanguages[strings.ToLower(lang)]; ok {
+		params["language"] = name
+	}
+	if title != "" {
+		params["title"] = title
+	}
+	if lineNumbers {
+		params["linenumbers"] = "true"
+	}
+
+	return storageMacroWithBody("code", params, "<ac:plain-text-body>"+storageCDATA(code)+"</ac:plain-text-body>")
+}
+
+// storageMacro renders a structured macro with the given parameters and an
+// optional rich-text body.
+func storageMacro(name string, params map[string]string, richText string) string {
+	body := ""
+	if richText != "" {
+		body = "<ac:rich-text-body>" + richText + "</ac:rich-text-body>"
+	}
+
+	return storageMacroWithBody(name, params, body)
+}
+
+func storageMacroWithBody(name string, params map[string]string, body string) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, `<ac:structured-macro ac:name="%s" ac:schema-version="1">`, name)
+	for _, key := range sortedKeys(params) {
+		fmt.Fprintf(&b, `<ac:parameter ac:name="%s">%s</ac:parameter>`, key, storageText(params[key]))
+	}
+	b.WriteString(body)
+	b.WriteString("</ac:structured-macro>")
+
+	return b.String()
+}
+
+// storageMethodStatus renders an HTTP method as a coloured status lozenge.
+func storageMethodStatus(method string) string {
+	method = formatMethod(method)
+
+	colour, ok := storageMethodColours[method]
+	if !ok {
+		colour = "Grey"
+	}
+
+	return storageMacro("status", map[string]string{"colour": colour, "title": method}, "")
+}
+
+// addMarkdown renders a CommonMark description as XHTML.
+func (c *ConfluenceStorageConverter) addMarkdown(src string) {
+	c.body.WriteString(storageBlocks(parseMarkdown(src)))
+}
+
+func storageBlocks(blocks []mdBlock) string {
+	var b strings.Builder
+
+	for _, block := range blocks {
+		switch block.Type {
+		case mdParagraph:
+			fmt.Fprintf(&b, "<p>%s</p>", storageInlines(block.Inlines))
+
+		case mdHeading:
+			fmt.Fprintf(&b, "<p><strong>%s</strong></p>", storageInlines(block.Inlines))
+
+		case mdBulletList, mdOrderedList:
+			tag := "ul"
+			if block.Type == mdOrderedList {
+				tag = "ol"
+			}
+
+			if block.Type == mdOrderedList && block.Start > 1 {
+				fmt.Fprintf(&b, `<ol start="%d">`, block.Start)
+			} else {
+				fmt.Fprintf(&b, "<%s>", tag)
+			}
+			for _, item := range block.Items {
+				fmt.Fprintf(&b, "<li>%s</li>", storageBlocks(item))
+			}
+			fmt.Fprintf(&b, "</%s>", tag)
+
+		case mdCodeBlock:
+			b.WriteString(storageCode(block.Code, block.Lang, "", false))
+
+		case mdQuote:
+			fmt.Fprintf(&b, "<blockquote>%s</blockquote>", storageBlocks(block.Blocks))
+
+		case mdRule:
+			b.WriteString("<hr />")
+		}
+	}
+
+	return b.String()
+}
+
+// storageInlines renders styled text runs as nested XHTML elements.
+func storageInlines(inlines []mdInline) string {
+	var b strings.Builder
+
+	for _, in := range inlines {
+		text := storageText(in.Text)
+		if in.Code {
+			text = "<code>" + text + "</code>"
+		}
+		if in.Italic {
+			text = "<em>" + text + "</em>"
+		}
+		if in.Bold {
+			text = "<strong>" + text + "</strong>"
+		}
+		if in.Link != "" {
+			text = fmt.Sprintf(`<a href="%s">%s</a>`, storageText(in.Link), text)
+		}
+
+		b.WriteString(text)
+	}
+
+	return b.String()
+}
+
+// storageText escapes text for XHTML content and attributes, turning line
+// breaks into <br /> elements.
+func storageText(s string) string {
+	lines := strings.Split(s, "\n")
+
+	for i, line := range lines {
+		var b strings.Builder
+		_ = xml.EscapeText(&b, []byte(line))
+		lines[i] = b.String()
+	}
+
+	return strings.Join(lines, "<br />")
+}
+
+// storageCDATA wraps text in a CDATA section, splitting any "]]>" it holds.
+func storageCDATA(s string) string {
+	return "<![CDATA[" + strings.ReplaceAll(s, "]]>", "]]]]><![CDATA[>") + "]]>"
+}
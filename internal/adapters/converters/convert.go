@@ -0,0 +1,98 @@
+package converters
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/GabrielNunesIT/openapi-converter/internal/domain"
+)
+
+// Convert runs a converter. Converters implementing domain.ContextConverter
+// stop once ctx is done and report their progress; others run to completion
+// once started and return no warnings.
+func Convert(ctx context.Context, converter domain.Converter, doc *domain.OpenAPIDocument, output io.Writer,
+	opts domain.ConvertOptions,
+) ([]domain.Warning, error) {
+	if cc, ok := converter.(domain.ContextConverter); ok {
+		return cc.ConvertContext(ctx, doc, output, opts)
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("conversion stopped: %w", err)
+	}
+
+	return nil, converter.Convert(doc, output)
+}
+
+// conversion follows a converter through a document layout: it reports the
+// sections and endpoints being rendered and tells the converter to stop once
+// the context is done.
+type conversion struct {
+	ctx      context.Context
+	progress func(domain.Progress)
+	warnings []domain.Warning
+
+	section string
+	done    int
+	total   int
+}
+
+func newConversion(ctx context.Context, layout *documentLayout, opts domain.ConvertOptions) *conversion {
+	conv := &conversion{
+		ctx:      ctx,
+		progress: opts.Progress,
+		warnings: layout.warnings,
+	}
+
+	for _, tag := range layout.tags {
+		conv.total += len(tag.endpoints)
+	}
+
+	return conv
+}
+
+// startSection reports that a section is being rendered. It returns false
+// when the conversion has been stopped.
+func (c *conversion) startSection(section layoutSection) bool {
+	if c.ctx.Err() != nil {
+		return false
+	}
+
+	c.section = section.title
+	c.report("")
+
+	return true
+}
+
+// startEndpoint reports that an endpoint is being rendered. It returns false
+// when the conversion has been stopped.
+func (c *conversion) startEndpoint(ep layoutEndpoint) bool {
+	if c.ctx.Err() != nil {
+		return false
+	}
+
+	c.report(fmt.Sprintf("%s %s", formatMethod(ep.method), ep.path))
+	c.done++
+
+	return true
+}
+
+// finish reports the end of the conversion, or returns the error it was
+// stopped with.
+func (c *conversion) finish() error {
+	if err := c.ctx.Err(); err != nil {
+		return fmt.Errorf("conversion stopped: %w", err)
+	}
+
+	c.section = ""
+	c.report("")
+
+	return nil
+}
+
+func (c *conversion) report(endpoint string) {
+	if c.progress != nil {
+		c.progress(domain.Progress{Section: c.section, Endpoint: endpoint, Done: c.done, Total: c.total})
+	}
+}
@@ -0,0 +1,125 @@
+package converters_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/GabrielNunesIT/openapi-converter/internal/adapters/converters"
+	"github.com/GabrielNunesIT/openapi-converter/internal/adapters/loaders"
+	"github.com/GabrielNunesIT/openapi-converter/internal/domain"
+)
+
+// contextConverters returns a converter for each built-in format that
+// supports cancellation and progress.
+func contextConverters(t *testing.T) map[string]domain.ContextConverter {
+	t.Helper()
+
+	registry := converters.DefaultRegistry()
+	result := make(map[string]domain.ContextConverter)
+
+	for _, name := range registry.Names() {
+		if name == converters.TemplateFormat {
+			continue
+		}
+
+		converter, err := registry.New(name, converters.Settings{GenerateExamples: true})
+		if err != nil {
+			t.Fatalf("New(%s) error = %v", name, err)
+		}
+		if cc, ok := converter.(domain.ContextConverter); ok {
+			result[name] = cc
+		}
+	}
+
+	if len(result) == 0 {
+		t.Fatal("no built-in format supports cancellation")
+	}
+
+	return result
+}
+
+func loadPetstore(t *testing.T) *domain.OpenAPIDocument {
+	t.Helper()
+
+	doc, _, err := loaders.NewOpenAPILoader().Load(context.Background(), "testdata/petstore.yaml")
+	if err != nil {
+		t.Fatalf("failed to load spec: %v", err)
+	}
+
+	return doc
+}
+
+func TestConvertContextReportsProgress(t *testing.T) {
+	doc := loadPetstore(t)
+
+	for name, converter := range contextConverters(t) {
+		t.Run(name, func(t *testing.T) {
+			var events []domain.Progress
+			opts := domain.ConvertOptions{Progress: func(p domain.Progress) { events = append(events, p) }}
+
+			if _, err := converter.ConvertContext(context.Background(), doc, io.Discard, opts); err != nil {
+				t.Fatalf("ConvertContext() error = %v", err)
+			}
+
+			if len(events) == 0 {
+				t.Fatal("no progress reported")
+			}
+			for i := 1; i < len(events); i++ {
+				if events[i].Done < events[i-1].Done {
+					t.Errorf("progress went back from %d to %d", events[i-1].Done, events[i].Done)
+				}
+			}
+
+			last := events[len(events)-1]
+			if last.Total == 0 || last.Done != last.Total {
+				t.Errorf("last progress = %+v, want all endpoints done", last)
+			}
+		})
+	}
+}
+
+func TestConvertContextStopsWhenCancelled(t *testing.T) {
+	doc := loadPetstore(t)
+
+	for name, converter := range contextConverters(t) {
+		t.Run(name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			// Cancelled once the first endpoint is being rendered
+			var last domain.Progress
+			opts := domain.ConvertOptions{Progress: func(p domain.Progress) {
+				last = p
+				if p.Done > 0 {
+					cancel()
+				}
+			}}
+
+			_, err := converter.ConvertContext(ctx, doc, io.Discard, opts)
+			if !errors.Is(err, context.Canceled) {
+				t.Fatalf("ConvertContext() error = %v, want context.Canceled", err)
+			}
+			if last.Done != 1 {
+				t.Errorf("last progress = %+v, want the conversion to stop after the first endpoint", last)
+			}
+		})
+	}
+}
+
+func TestConvertContextCancelledBeforehand(t *testing.T) {
+	doc := loadPetstore(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	for name, converter := range contextConverters(t) {
+		t.Run(name, func(t *testing.T) {
+			_, err := converter.ConvertContext(ctx, doc, io.Discard, domain.ConvertOptions{})
+			if !errors.Is(err, context.Canceled) {
+				t.Errorf("ConvertContext() error = %v, want context.Canceled", err)
+			}
+		})
+	}
+}
@@ -1,23 +1,65 @@
 package converters
 
 import (
+	"bytes"
+	"context"
 	"fmt"
 	"io"
 	"sort"
+	"strings"
 
 	"github.com/GabrielNunesIT/openapi-converter/internal/domain"
 	"github.com/gomutex/godocx"
 	"github.com/gomutex/godocx/docx"
 )
 
-const docxFormat = "docx"
+const (
+	docxFormat          = "docx"
+	docxLineNumberColor = "999999"
+)
 
 // DocxConverter converts OpenAPI documents to Word (DOCX) format.
-type DocxConverter struct{}
+type DocxConverter struct {
+	generateExamples bool
+	synth            *exampleSynthesizer // Set per conversion when generateExamples is enabled
+	body             *docxBody           // Elements of the current document godocx cannot create
+	textWidth        int                 // Width between the page margins in twips
+	anchors          map[string]string   // Map "kind:tag:name" keys to bookmark names
+	currentTag       layoutTag           // Current tag context for link resolution
+	conv             *conversion         // Progress of the current conversion
+	referenceDoc     string
+}
+
+// DocxOption configures a DocxConverter.
+type DocxOption func(*DocxConverter)
+
+// WithDocxGeneratedExamples enables synthesizing examples from schemas for
+// request and response bodies that have none.
+func WithDocxGeneratedExamples(enabled bool) DocxOption {
+	return func(c *DocxConverter) {
+		c.generateExamples = enabled
+	}
+}
+
+// WithReferenceDoc starts the output from a .docx or .dotx reference document,
+// reusing its styles, headers, footers and body content such as a cover page.
+// Generated elements use the named styles in docx_styles.go, so the reference
+// document can restyle them by defining styles with the same IDs.
+func WithReferenceDoc(fileName string) DocxOption {
+	return func(c *DocxConverter) {
+		c.referenceDoc = fileName
+	}
+}
 
 // NewDocxConverter creates a new DOCX converter.
-func NewDocxConverter() *DocxConverter {
-	return &DocxConverter{}
+func NewDocxConverter(opts ...DocxOption) *DocxConverter {
+	c := &DocxConverter{}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
 }
 
 // Format returns the output format name.
@@ -27,192 +69,276 @@ func (c *DocxConverter) Format() string {
 
 // Convert transforms an OpenAPI document to DOCX format.
 func (c *DocxConverter) Convert(doc *domain.OpenAPIDocument, output io.Writer) error {
+	_, err := c.ConvertContext(context.Background(), doc, output, domain.ConvertOptions{})
+
+	return err
+}
+
+// ConvertContext implements domain.ContextConverter.
+func (c *DocxConverter) ConvertContext(ctx context.Context, doc *domain.OpenAPIDocument, output io.Writer,
+	opts domain.ConvertOptions,
+) ([]domain.Warning, error) {
 	document, err := godocx.NewDocument()
 	if err != nil {
-		return fmt.Errorf("failed to create document: %w", err)
+		return nil, fmt.Errorf("failed to create document: %w", err)
 	}
 
-	c.addTitle(document, doc)
-	c.addDescription(document, doc)
-	c.addServers(document, doc)
-	c.addPaths(document, doc)
+	var reference *docxReference
+	c.textWidth = docxTextWidth(document)
+	if c.referenceDoc != "" {
+		reference, err = loadDocxReference(c.referenceDoc)
+		if err != nil {
+			return nil, err
+		}
+		c.textWidth = reference.textWidth()
+	}
 
-	if err := document.Write(output); err != nil {
-		return fmt.Errorf("failed to write document: %w", err)
+	layout := newDocumentLayout(doc)
+	c.conv = newConversion(ctx, layout, opts)
+
+	c.body = newDocxBody()
+	c.anchors = make(map[string]string)
+	c.currentTag = layoutTag{}
+	c.synth = nil
+	if c.generateExamples {
+		c.synth = newExampleSynthesizer(doc.Components)
 	}
 
-	return nil
-}
+	c.addTitle(document, doc)
 
-func (c *DocxConverter) addTitle(document *docx.RootDoc, doc *domain.OpenAPIDocument) {
-	_, _ = document.AddHeading(doc.Title, 0) // Level 0 = Title style
-	document.AddParagraph(fmt.Sprintf("Version: %s", doc.Version))
-	document.AddEmptyParagraph()
-}
+	for _, section := range layout.sections {
+		if !c.conv.startSection(section) {
+			break
+		}
 
-func (c *DocxConverter) addDescription(document *docx.RootDoc, doc *domain.OpenAPIDocument) {
-	if doc.Description == "" {
-		return
+		switch section.kind {
+		case sectionDescription:
+			c.addDescription(document, section, doc)
+		case sectionAuthentication:
+			c.addSecurity(document, section, doc)
+		case sectionServers:
+			c.addServers(document, section, doc)
+		case sectionEndpoints:
+			c.addPaths(document, section, layout)
+		}
 	}
 
-	_, _ = document.AddHeading("Description", 1)
-	document.AddParagraph(doc.Description)
-	document.AddEmptyParagraph()
-}
+	if err := c.conv.finish(); err != nil {
+		return c.conv.warnings, err
+	}
 
-func (c *DocxConverter) addServers(document *docx.RootDoc, doc *domain.OpenAPIDocument) {
-	if len(doc.Servers) == 0 {
-		return
+	var buf bytes.Buffer
+	if err := document.Write(&buf); err != nil {
+		return c.conv.warnings, fmt.Errorf("failed to write document: %w", err)
 	}
 
-	_, _ = document.AddHeading("Servers", 1)
+	documentXML, err := c.body.encode(document)
+	if err != nil {
+		return c.conv.warnings, fmt.Errorf("failed to write document: %w", err)
+	}
 
-	for _, server := range doc.Servers {
-		text := server.URL
-		if server.Description != "" {
-			text = fmt.Sprintf("%s - %s", server.URL, server.Description)
+	rewrites := map[string]func(string) string{
+		docxDocumentPart: func(string) string {
+			return string(documentXML)
+		},
+		docxSettingsPart: enableUpdateFields,
+		docxStylesPart:   ensureDocxStyles,
+	}
+
+	if reference == nil {
+		if err := rewriteDocxParts(buf.Bytes(), rewrites, nil, output); err != nil {
+			return c.conv.warnings, fmt.Errorf("failed to write document: %w", err)
 		}
 
-		document.AddParagraph(fmt.Sprintf("• %s", text))
+		return c.conv.warnings, nil
 	}
 
+	var generated bytes.Buffer
+	if err := rewriteDocxParts(buf.Bytes(), rewrites, nil, &generated); err != nil {
+		return c.conv.warnings, fmt.Errorf("failed to write document: %w", err)
+	}
+
+	if err := reference.apply(generated.Bytes(), output); err != nil {
+		return c.conv.warnings, fmt.Errorf("failed to apply reference document: %w", err)
+	}
+
+	return c.conv.warnings, nil
+}
+
+func (c *DocxConverter) addTitle(document *docx.RootDoc, doc *domain.OpenAPIDocument) {
+	_, _ = document.AddHeading(doc.Title, 0) // Level 0 = Title style
+	document.AddParagraph(fmt.Sprintf("Version: %s", doc.Version)).Style(docxStyleSubtitle)
 	document.AddEmptyParagraph()
+
+	c.addTableOfContents(document)
 }
 
-type docxEndpointRef struct {
-	path      string
-	method    string
-	operation domain.Operation
+// addTableOfContents inserts a TOC field that Word fills in when the document is opened.
+func (c *DocxConverter) addTableOfContents(document *docx.RootDoc) {
+	document.AddParagraph("Contents").Style(docxStyleTOCHeading)
+	c.body.add(document, docxTOCField{})
+	document.AddEmptyParagraph()
 }
 
-// groupPathsByTag groups paths by their operation tags.
-func (c *DocxConverter) groupPathsByTag(doc *domain.OpenAPIDocument) map[string][]docxEndpointRef {
-	result := make(map[string][]docxEndpointRef)
+// anchor returns the bookmark name for a key, allocating one on first use so
+// links can be created before their target is rendered.
+func (c *DocxConverter) anchor(key string) string {
+	name, ok := c.anchors[key]
+	if !ok {
+		name = fmt.Sprintf("ocRef%d", len(c.anchors)+1)
+		c.anchors[key] = name
+	}
 
-	for _, path := range doc.Paths {
-		for _, op := range path.Operations {
-			tags := op.Tags
-			if len(tags) == 0 {
-				tags = []string{"Default"}
-			}
+	return name
+}
 
-			for _, tag := range tags {
-				result[tag] = append(result[tag], docxEndpointRef{
-					path:      path.Path,
-					method:    op.Method,
-					operation: op,
-				})
-			}
-		}
+// addBookmark marks a paragraph as the target of internal links to key.
+func (c *DocxConverter) addBookmark(p *docx.Paragraph, key string) {
+	if p != nil {
+		c.body.bookmark(p, c.anchor(key))
 	}
+}
 
-	// Sort endpoints within each tag by path then method
-	for tag := range result {
-		sort.Slice(result[tag], func(i, j int) bool {
-			if result[tag][i].path == result[tag][j].path {
-				return result[tag][i].method < result[tag][j].method
-			}
+// link returns a table cell that links to key.
+func (c *DocxConverter) link(key, text string) docxCell {
+	return docxCell{text: text, anchor: c.anchor(key)}
+}
 
-			return result[tag][i].path < result[tag][j].path
-		})
+// cell returns a table cell holding a link, pointing at its bookmark when it
+// has a target.
+func (c *DocxConverter) cell(link layoutLink) docxCell {
+	if link.key == "" {
+		return docxCell{text: link.text}
 	}
 
-	return result
+	return c.link(link.key, link.text)
 }
 
-// collectTagComponents gathers all unique component names used by endpoints in a tag.
-func (c *DocxConverter) collectTagComponents(endpoints []docxEndpointRef) []string {
-	componentSet := make(map[string]struct{})
+func (c *DocxConverter) addDescription(document *docx.RootDoc, section layoutSection, doc *domain.OpenAPIDocument) {
+	_, _ = document.AddHeading(section.title, 1)
+	c.addMarkdown(document, doc.Description)
+	document.AddEmptyParagraph()
+}
 
-	for _, ep := range endpoints {
-		// Check request body
-		if ep.operation.RequestBody != nil {
-			for _, media := range ep.operation.RequestBody.Content {
-				c.collectSchemaRefs(media.Schema, componentSet)
-			}
-		}
+func (c *DocxConverter) addSecurity(document *docx.RootDoc, section layoutSection, doc *domain.OpenAPIDocument) {
+	_, _ = document.AddHeading(section.title, 1)
 
-		// Check responses
-		for _, resp := range ep.operation.Responses {
-			for _, media := range resp.Content {
-				c.collectSchemaRefs(media.Schema, componentSet)
-			}
+	for _, name := range sortedKeys(doc.SecuritySchemes) {
+		scheme := doc.SecuritySchemes[name]
+
+		_, _ = document.AddHeading(name, 3)
+		c.addField(document, "Type", scheme.Type)
+
+		if scheme.In != "" {
+			c.addField(document, "In", scheme.In)
+		}
+		if scheme.Name != "" && scheme.Name != name {
+			c.addField(document, "Name", scheme.Name)
+		}
+		if scheme.Scheme != "" {
+			c.addField(document, "Scheme", scheme.Scheme)
 		}
 
-		// Check parameters
-		for _, param := range ep.operation.Parameters {
-			c.collectSchemaRefs(param.Schema, componentSet)
+		if scheme.Description != "" {
+			c.addMarkdown(document, scheme.Description)
 		}
 	}
 
-	// Convert set to sorted slice
-	components := make([]string, 0, len(componentSet))
-	for name := range componentSet {
-		components = append(components, name)
-	}
-	sort.Strings(components)
+	document.AddEmptyParagraph()
+}
 
-	return components
+// addField adds a "Label: value" paragraph with a bold label.
+func (c *DocxConverter) addField(document *docx.RootDoc, label, value string) {
+	p := document.AddEmptyParagraph()
+	p.AddText(label + ": ").Bold(true)
+	p.AddText(value)
 }
 
-// collectSchemaRefs recursively collects component references from a schema.
-func (c *DocxConverter) collectSchemaRefs(schema domain.Schema, refs map[string]struct{}) {
-	if schema.Ref != "" {
-		refs[extractRefName(schema.Ref)] = struct{}{}
-	}
+func (c *DocxConverter) addServers(document *docx.RootDoc, section layoutSection, doc *domain.OpenAPIDocument) {
+	_, _ = document.AddHeading(section.title, 1)
 
-	for _, prop := range schema.Properties {
-		c.collectSchemaRefs(prop, refs)
-	}
+	for _, server := range doc.Servers {
+		text := server.URL
+		if server.Description != "" {
+			text = fmt.Sprintf("%s - %s", server.URL, server.Description)
+		}
 
-	if schema.Items != nil {
-		c.collectSchemaRefs(*schema.Items, refs)
+		document.AddParagraph(fmt.Sprintf("• %s", text))
 	}
-}
 
-func (c *DocxConverter) addPaths(document *docx.RootDoc, doc *domain.OpenAPIDocument) {
-	if len(doc.Paths) == 0 {
-		return
-	}
+	document.AddEmptyParagraph()
+}
 
-	_, _ = document.AddHeading("API Endpoints", 1)
+func (c *DocxConverter) addPaths(document *docx.RootDoc, section layoutSection, layout *documentLayout) {
+	_, _ = document.AddHeading(section.title, 1)
 
-	// Group by tags
-	tagPaths := c.groupPathsByTag(doc)
-	tags := make([]string, 0, len(tagPaths))
-	for tag := range tagPaths {
-		tags = append(tags, tag)
-	}
-	sort.Strings(tags)
+	for _, tag := range layout.tags {
+		c.currentTag = tag
 
-	for _, tag := range tags {
 		// Tag header
-		_, _ = document.AddHeading(tag, 2)
+		p, _ := document.AddHeading(tag.name, 2)
+		c.addBookmark(p, tag.key)
+
+		if tag.description != "" {
+			c.addMarkdown(document, tag.description)
+		}
+
+		c.addEndpointsSummary(document, tag)
 
 		// Add components used by this tag's endpoints
-		tagComponents := c.collectTagComponents(tagPaths[tag])
-		if len(tagComponents) > 0 {
-			c.addTagComponents(document, tagComponents, doc.Components)
+		if len(tag.components) > 0 {
+			c.addTagComponents(document, tag.components)
 		}
 
 		// Add endpoints
-		for _, ep := range tagPaths[tag] {
+		for _, ep := range tag.endpoints {
+			if !c.conv.startEndpoint(ep) {
+				return
+			}
+
 			c.addOperation(document, ep.path, ep.operation)
 		}
 	}
 }
 
+// addEndpointsSummary renders the endpoints table of a tag under a caption.
+func (c *DocxConverter) addEndpointsSummary(document *docx.RootDoc, tag layoutTag) {
+	if len(tag.endpoints) == 0 {
+		return
+	}
+
+	document.AddParagraph(endpointsSummaryTitle).Style(docxStyleCaption)
+
+	rows := make([][]docxCell, 0, len(tag.endpoints))
+	for _, row := range tag.summaryRows() {
+		rows = append(rows, []docxCell{c.cell(row.summary), c.cell(row.path), {text: formatMethod(row.method)}})
+	}
+
+	c.addTable(document, endpointsSummaryWidths, endpointsSummaryHeaders, rows)
+	document.AddEmptyParagraph()
+}
+
+// addTable adds a table with a header row. Column widths are relative and
+// stretched to the page text width.
+func (c *DocxConverter) addTable(document *docx.RootDoc, widths []float64, headers []string, rows [][]docxCell) {
+	header := make([]docxCell, len(headers))
+	for i, text := range headers {
+		header[i] = docxCell{text: text}
+	}
+
+	c.body.add(document, docxTable{
+		widths:  scaleTwips(widths, c.textWidth),
+		rows:    append([][]docxCell{header}, rows...),
+		defined: c.body.defined,
+	})
+}
+
 // addTagComponents renders the component schemas used by endpoints in a tag.
-func (c *DocxConverter) addTagComponents(document *docx.RootDoc, componentNames []string, components map[string]domain.Schema) {
+func (c *DocxConverter) addTagComponents(document *docx.RootDoc, components []layoutComponent) {
 	_, _ = document.AddHeading("Schemas Used", 3)
 
-	for _, name := range componentNames {
-		schema, exists := components[name]
-		if !exists {
-			continue
-		}
-
-		c.addComponentSchema(document, name, schema)
+	for _, component := range components {
+		c.addComponentSchema(document, component.name, component.schema)
 	}
 
 	document.AddEmptyParagraph()
@@ -221,7 +347,8 @@ func (c *DocxConverter) addTagComponents(document *docx.RootDoc, componentNames
 // addComponentSchema renders a single component schema.
 func (c *DocxConverter) addComponentSchema(document *docx.RootDoc, name string, schema domain.Schema) {
 	// Schema name as bold heading
-	_, _ = document.AddHeading(name, 4)
+	p, _ := document.AddHeading(name, 4)
+	c.addBookmark(p, componentKey(c.currentTag.name, name))
 
 	// Type info
 	if schema.Type != "" {
@@ -234,35 +361,27 @@ func (c *DocxConverter) addComponentSchema(document *docx.RootDoc, name string,
 
 	// Description
 	if schema.Description != "" {
-		document.AddParagraph(schema.Description)
+		c.addMarkdown(document, schema.Description)
 	}
 
 	// Properties
 	if len(schema.Properties) > 0 {
-		document.AddParagraph("Properties:")
-
-		propNames := make([]string, 0, len(schema.Properties))
-		for propName := range schema.Properties {
-			propNames = append(propNames, propName)
-		}
-		sort.Strings(propNames)
+		rows := make([][]docxCell, 0, len(schema.Properties))
 
-		for _, propName := range propNames {
+		for _, propName := range sortedKeys(schema.Properties) {
 			prop := schema.Properties[propName]
-			propType := prop.Type
+			propType := docxCell{text: prop.Type}
 			if prop.Ref != "" {
-				propType = extractRefName(prop.Ref)
+				refName := extractRefName(prop.Ref)
+				propType = c.cell(c.currentTag.componentLink(refName, refName))
 			} else if prop.Format != "" {
-				propType = fmt.Sprintf("%s (%s)", prop.Type, prop.Format)
-			}
-
-			propDesc := ""
-			if prop.Description != "" {
-				propDesc = fmt.Sprintf(" - %s", prop.Description)
+				propType.text = fmt.Sprintf("%s (%s)", prop.Type, prop.Format)
 			}
 
-			document.AddParagraph(fmt.Sprintf("  • %s (%s)%s", propName, propType, propDesc))
+			rows = append(rows, []docxCell{{text: propName}, propType, {text: markdownToText(prop.Description)}})
 		}
+
+		c.addTable(document, []float64{50, 50, 90}, []string{"Name", "Type", "Description"}, rows)
 	}
 
 	document.AddEmptyParagraph()
@@ -270,7 +389,8 @@ func (c *DocxConverter) addComponentSchema(document *docx.RootDoc, name string,
 
 func (c *DocxConverter) addOperation(document *docx.RootDoc, pathStr string, op domain.Operation) {
 	// Method and path header
-	_, _ = document.AddHeading(fmt.Sprintf("%s %s", formatMethod(op.Method), pathStr), 3)
+	p, _ := document.AddHeading(fmt.Sprintf("%s %s", formatMethod(op.Method), pathStr), 3)
+	c.addBookmark(p, endpointKey(c.currentTag.name, op.Method, pathStr))
 
 	// Summary
 	if op.Summary != "" {
@@ -279,31 +399,223 @@ func (c *DocxConverter) addOperation(document *docx.RootDoc, pathStr string, op
 
 	// Description
 	if op.Description != "" {
-		document.AddParagraph(op.Description)
+		c.addMarkdown(document, op.Description)
 	}
 
 	// Parameters
 	if len(op.Parameters) > 0 {
 		_, _ = document.AddHeading("Parameters", 4)
+		c.addParameterTable(document, op.Parameters)
+	}
 
-		for _, param := range op.Parameters {
-			required := ""
-			if param.Required {
-				required = " (required)"
-			}
-
-			document.AddParagraph(fmt.Sprintf("• %s (%s): %s%s", param.Name, param.In, param.Description, required))
-		}
+	// Request body
+	if op.RequestBody != nil {
+		_, _ = document.AddHeading("Request Body", 4)
+		c.addRequestBody(document, op.RequestBody)
 	}
 
 	// Responses
 	if len(op.Responses) > 0 {
 		_, _ = document.AddHeading("Responses", 4)
+		c.addResponseTable(document, op.Responses)
+	}
 
-		for _, resp := range op.Responses {
-			document.AddParagraph(fmt.Sprintf("• %s: %s", resp.StatusCode, resp.Description))
+	// Examples
+	if examples := responseExamples(op.Responses, c.synth); len(examples) > 0 {
+		_, _ = document.AddHeading("Response Examples", 4)
+		for _, ex := range examples {
+			c.addExample(document, ex)
 		}
 	}
 
 	document.AddEmptyParagraph()
 }
+
+func (c *DocxConverter) addParameterTable(document *docx.RootDoc, params []domain.Parameter) {
+	rows := make([][]docxCell, 0, len(params))
+
+	for _, param := range params {
+		required := "No"
+		if param.Required {
+			required = "Yes"
+		}
+
+		schemaType := docxCell{text: param.Schema.Type}
+		if param.Schema.Format != "" {
+			schemaType.text = fmt.Sprintf("%s (%s)", param.Schema.Type, param.Schema.Format)
+		}
+		if param.Schema.Ref != "" {
+			refName := extractRefName(param.Schema.Ref)
+			schemaType = c.cell(c.currentTag.componentLink(refName, refName))
+		}
+
+		rows = append(rows, []docxCell{
+			{text: param.Name}, {text: param.In}, {text: required}, schemaType, {text: markdownToText(param.Description)},
+		})
+	}
+
+	c.addTable(document, []float64{35, 20, 15, 60, 60}, []string{"Name", "In", "Required", "Type", "Description"}, rows)
+}
+
+func (c *DocxConverter) addResponseTable(document *docx.RootDoc, responses []domain.Response) {
+	sorted := make([]domain.Response, len(responses))
+	copy(sorted, responses)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].StatusCode < sorted[j].StatusCode
+	})
+
+	rows := make([][]docxCell, 0, len(sorted))
+
+	for _, resp := range sorted {
+		var object docxCell
+		if contentTypes := sortedKeys(resp.Content); len(contentTypes) > 0 {
+			object = c.cell(c.currentTag.componentLink(schemaTypeName(resp.Content[contentTypes[0]].Schema)))
+		}
+
+		rows = append(rows, []docxCell{{text: resp.StatusCode}, {text: markdownToText(resp.Description)}, object})
+	}
+
+	c.addTable(document, []float64{25, 95, 70}, []string{"Status", "Description", "Object"}, rows)
+}
+
+func (c *DocxConverter) addRequestBody(document *docx.RootDoc, rb *domain.RequestBody) {
+	if rb.Required {
+		document.AddEmptyParagraph().AddText("Required").Italic(true)
+	}
+
+	if rb.Description != "" {
+		c.addMarkdown(document, rb.Description)
+	}
+
+	if len(rb.Content) == 0 {
+		return
+	}
+
+	rows := make([][]docxCell, 0, len(rb.Content))
+	for _, contentType := range sortedKeys(rb.Content) {
+		typeName := c.cell(c.currentTag.componentLink(schemaTypeName(rb.Content[contentType].Schema)))
+		rows = append(rows, []docxCell{{text: contentType}, typeName})
+	}
+
+	c.addTable(document, []float64{60, 130}, []string{"Content-Type", "Object"}, rows)
+
+	if examples := requestExamples(rb, c.synth); len(examples) > 0 {
+		_, _ = document.AddHeading("Request Examples", 4)
+		for _, ex := range examples {
+			c.addExample(document, ex)
+		}
+	}
+}
+
+// addExample renders a formatted example as shaded, line-numbered code
+// paragraphs with one colored run per token.
+func (c *DocxConverter) addExample(document *docx.RootDoc, ex namedExample) {
+	document.AddParagraph(ex.title).Style(docxStyleCaption)
+
+	code, lang := formatExample(ex.mediaType, ex.value)
+	lines := highlightExample(code, lang)
+	numWidth := len(fmt.Sprint(len(lines)))
+
+	for i, line := range lines {
+		p := document.AddEmptyParagraph()
+		p.Style(docxStyleSourceCode)
+		p.AddText(fmt.Sprintf("%*d  ", numWidth, i+1)).Color(docxLineNumberColor)
+
+		for _, tok := range line {
+			run := p.AddText(tok.Text)
+			if tok.Kind != tokenPlain {
+				run.Color(tokenHexColor(tok.Kind))
+			}
+		}
+	}
+}
+
+// addMarkdown renders a CommonMark description as styled Word paragraphs.
+func (c *DocxConverter) addMarkdown(document *docx.RootDoc, src string) {
+	c.addMarkdownBlocks(document, parseMarkdown(src), 0)
+}
+
+func (c *DocxConverter) addMarkdownBlocks(document *docx.RootDoc, blocks []mdBlock, depth int) {
+	for _, block := range blocks {
+		switch block.Type {
+		case mdParagraph:
+			c.addInlines(document.AddEmptyParagraph(), block.Inlines)
+
+		case mdHeading:
+			p := document.AddEmptyParagraph()
+			for _, in := range block.Inlines {
+				in.Bold = true
+				c.addInline(p, in)
+			}
+
+		case mdBulletList, mdOrderedList:
+			style := docxStyleBullet
+			if block.Type == mdOrderedList {
+				style = docxStyleNumber
+			}
+			// The default template defines list styles for three nesting levels
+			if level := min(depth, 2); level > 0 {
+				style = fmt.Sprintf("%s%d", style, level+1)
+			}
+
+			for _, item := range block.Items {
+				for k, child := range item {
+					if k == 0 && child.Type == mdParagraph {
+						p := document.AddEmptyParagraph()
+						p.Style(style)
+						c.addInlines(p, child.Inlines)
+						continue
+					}
+					c.addMarkdownBlocks(document, []mdBlock{child}, depth+1)
+				}
+			}
+
+		case mdCodeBlock:
+			for _, line := range strings.Split(block.Code, "\n") {
+				p := document.AddEmptyParagraph()
+				p.Style(docxStyleSourceCode)
+				p.AddText(line)
+			}
+
+		case mdQuote:
+			start := len(document.Document.Body.Children)
+			c.addMarkdownBlocks(document, block.Blocks, depth)
+			for _, child := range document.Document.Body.Children[start:] {
+				if child.Para != nil {
+					child.Para.Style(docxStyleQuote)
+				}
+			}
+
+		case mdRule:
+			document.AddEmptyParagraph()
+		}
+	}
+}
+
+func (c *DocxConverter) addInlines(p *docx.Paragraph, inlines []mdInline) {
+	for _, in := range inlines {
+		c.addInline(p, in)
+	}
+}
+
+// addInline appends a styled run to a paragraph. Links are rendered in the
+// hyperlink style followed by their destination.
+func (c *DocxConverter) addInline(p *docx.Paragraph, in mdInline) {
+	run := p.AddText(in.Text)
+	if in.Bold {
+		run.Bold(true)
+	}
+	if in.Italic {
+		run.Italic(true)
+	}
+
+	switch {
+	case in.Link != "":
+		run.Style(docxStyleHyperlink)
+		if in.Link != in.Text {
+			p.AddText(fmt.Sprintf(" (%s)", in.Link))
+		}
+	case in.Code:
+		run.Style(docxStyleVerbatim)
+	}
+}
@@ -0,0 +1,381 @@
+package converters
+
+import (
+	"encoding/xml"
+	"fmt"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	docxNumberingPart        = "word/numbering.xml"
+	docxDocumentRelsPart     = "word/_rels/document.xml.rels"
+	docxNamespaceRelsPackage = "http://schemas.openxmlformats.org/package/2006/relationships"
+	docxNumberingRelType     = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/numbering"
+	docxNumberingContentType = "application/vnd.openxmlformats-officedocument.wordprocessingml.numbering+xml"
+	docxExternalTargetMode   = "External"
+)
+
+var (
+	docxRelRefPattern      = regexp.MustCompile(`\br:(?:id|embed|link)="([^"]+)"`)
+	docxNumRefPattern      = regexp.MustCompile(`<w:numId w:val="(\d+)"`)
+	docxNumPattern         = regexp.MustCompile(`(?s)<w:num\b[^>]*\bw:numId="(\d+)"[^>]*>.*?</w:num>`)
+	docxAbstractNumPattern = regexp.MustCompile(`(?s)<w:abstractNum\b[^>]*\bw:abstractNumId="(\d+)"[^>]*>.*?</w:abstractNum>`)
+	docxAbstractRefPattern = regexp.MustCompile(`<w:abstractNumId w:val="(\d+)"`)
+	docxNumberingPattern   = regexp.MustCompile(`<w:numbering\b[^>]*>`)
+	docxBookmarkIDPattern  = regexp.MustCompile(`(<w:bookmark(?:Start|End)\b[^>]*\bw:id=")(\d+)"`)
+)
+
+// docxRelationships is a relationships part.
+type docxRelationships struct {
+	Relationships []docxRelationship `xml:"Relationship"`
+}
+
+// docxRelationship links a part to another part or an external resource.
+type docxRelationship struct {
+	ID         string `xml:"Id,attr"`
+	Type       string `xml:"Type,attr"`
+	Target     string `xml:"Target,attr"`
+	TargetMode string `xml:"TargetMode,attr"`
+}
+
+// target returns the target of the first relationship of a type, given by
+// the last segment of its URI, or "" when there is none.
+func (r docxRelationships) target(relType string) string {
+	for _, rel := range r.Relationships {
+		if path.Base(rel.Type) == relType {
+			return rel.Target
+		}
+	}
+
+	return ""
+}
+
+// docxContentTypes is the content types part of a package.
+type docxContentTypes struct {
+	Defaults []struct {
+		Extension   string `xml:"Extension,attr"`
+		ContentType string `xml:"ContentType,attr"`
+	} `xml:"Default"`
+	Overrides []struct {
+		PartName    string `xml:"PartName,attr"`
+		ContentType string `xml:"ContentType,attr"`
+	} `xml:"Override"`
+}
+
+// of returns the content type of a part, or "" when it has none.
+func (t docxContentTypes) of(part string) string {
+	for _, override := range t.Overrides {
+		if strings.TrimPrefix(override.PartName, "/") == part {
+			return override.ContentType
+		}
+	}
+
+	ext := strings.TrimPrefix(path.Ext(part), ".")
+	for _, def := range t.Defaults {
+		if strings.EqualFold(def.Extension, ext) {
+			return def.ContentType
+		}
+	}
+
+	return ""
+}
+
+// docxMerge carries over what the generated body needs from its own package
+// when it is inserted into a reference document: the relationships and parts
+// it links to, its numbering definitions and unique bookmark IDs.
+type docxMerge struct {
+	ref       *docxReference
+	source    []byte            // Generated package
+	generated map[string]string // Parts of the generated package
+	genTypes  docxContentTypes
+	refTypes  docxContentTypes
+	genRels   map[string]docxRelationship // Generated document relationships by ID
+
+	files  map[string]bool // Part names in use in the output package
+	relIDs map[string]bool // Relationship IDs in use in the reference document
+	nextID int
+
+	added     map[string][]byte // Parts to add to the output package
+	rels      strings.Builder   // Relationship elements to add
+	overrides strings.Builder   // Content type overrides to add
+	numbering func(string) string
+}
+
+// newDocxMerge prepares merging a generated package into a reference.
+func newDocxMerge(ref *docxReference, source []byte, generated, reference map[string]string) (*docxMerge, error) {
+	m := &docxMerge{
+		ref:       ref,
+		source:    source,
+		generated: generated,
+		genRels:   make(map[string]docxRelationship),
+		files:     make(map[string]bool),
+		relIDs:    make(map[string]bool),
+		added:     make(map[string][]byte),
+	}
+
+	if err := unmarshalDocxPart(generated[docxContentTypesPart], &m.genTypes); err != nil {
+		return nil, err
+	}
+	if err := unmarshalDocxPart(reference[docxContentTypesPart], &m.refTypes); err != nil {
+		return nil, err
+	}
+
+	var genRels, refRels docxRelationships
+	if err := unmarshalDocxPart(generated[docxDocumentRelsPart], &genRels); err != nil {
+		return nil, err
+	}
+	if err := unmarshalDocxPart(reference[ref.relsPart], &refRels); err != nil {
+		return nil, err
+	}
+
+	for _, rel := range genRels.Relationships {
+		m.genRels[rel.ID] = rel
+	}
+	for _, rel := range refRels.Relationships {
+		m.relIDs[rel.ID] = true
+	}
+	for _, name := range ref.files {
+		m.files[name] = true
+	}
+
+	return m, nil
+}
+
+func unmarshalDocxPart(content string, v any) error {
+	if content == "" {
+		return nil
+	}
+	if err := xml.Unmarshal([]byte(content), v); err != nil {
+		return fmt.Errorf("failed to parse document part: %w", err)
+	}
+
+	return nil
+}
+
+// relationships adds to the reference document the relationships body refers
+// to, copying the parts they target, and renumbers the references.
+func (m *docxMerge) relationships(body string) (string, error) {
+	ids := make(map[string]string)
+	for _, match := range docxRelRefPattern.FindAllStringSubmatch(body, -1) {
+		rel, ok := m.genRels[match[1]]
+		if _, done := ids[match[1]]; done || !ok {
+			continue
+		}
+
+		if rel.TargetMode != docxExternalTargetMode {
+			source := resolveDocxTarget(docxDocumentPart, rel.Target)
+			parts, err := readDocxParts(m.source, source)
+			if err != nil {
+				return "", err
+			}
+			if content, found := parts[source]; found {
+				name := strings.TrimPrefix(source, path.Dir(docxDocumentPart)+"/")
+				rel.Target = m.addPart(path.Join(path.Dir(m.ref.documentPart), name), []byte(content), m.genTypes.of(source))
+			}
+		}
+
+		ids[match[1]] = m.addRelationship(rel)
+	}
+
+	return docxRelRefPattern.ReplaceAllStringFunc(body, func(ref string) string {
+		sub := docxRelRefPattern.FindStringSubmatch(ref)
+		if id, ok := ids[sub[1]]; ok {
+			return strings.Replace(ref, `"`+sub[1]+`"`, `"`+id+`"`, 1)
+		}
+
+		return ref
+	}), nil
+}
+
+// addRelationship adds a relationship to the reference document and returns
+// its ID there.
+func (m *docxMerge) addRelationship(rel docxRelationship) string {
+	rel.ID = m.newRelID()
+	m.rels.WriteString(`<Relationship Id="` + rel.ID + `" Type="` + xmlEscape(rel.Type) +
+		`" Target="` + xmlEscape(rel.Target) + `"`)
+	if rel.TargetMode != "" {
+		m.rels.WriteString(` TargetMode="` + xmlEscape(rel.TargetMode) + `"`)
+	}
+	m.rels.WriteString(`/>`)
+
+	return rel.ID
+}
+
+// addPart adds a part under a name not used in the reference package and
+// returns its target relative to the reference document part.
+func (m *docxMerge) addPart(name string, content []byte, contentType string) string {
+	ext := path.Ext(name)
+	base := strings.TrimSuffix(name, ext)
+	for i := 1; m.files[name]; i++ {
+		name = base + "-" + strconv.Itoa(i) + ext
+	}
+	m.files[name] = true
+	m.added[name] = content
+
+	if contentType != "" && m.refTypes.of(name) != contentType {
+		m.overrides.WriteString(`<Override PartName="/` + xmlEscape(name) + `" ContentType="` + xmlEscape(contentType) + `"/>`)
+	}
+
+	return strings.TrimPrefix(name, path.Dir(m.ref.documentPart)+"/")
+}
+
+func (m *docxMerge) newRelID() string {
+	for {
+		m.nextID++
+		id := "rId" + strconv.Itoa(m.nextID)
+		if !m.relIDs[id] {
+			m.relIDs[id] = true
+			return id
+		}
+	}
+}
+
+// mergeNumbering copies the numbering definitions referenced by body and
+// styles into the reference document and renumbers the references.
+func (m *docxMerge) mergeNumbering(body, styles, numbering string) (string, string) {
+	source := m.generated[docxNumberingPart]
+
+	nums := make(map[string]string)
+	for _, match := range docxNumPattern.FindAllStringSubmatch(source, -1) {
+		nums[match[1]] = match[0]
+	}
+	abstracts := make(map[string]string)
+	for _, match := range docxAbstractNumPattern.FindAllStringSubmatch(source, -1) {
+		abstracts[match[1]] = match[0]
+	}
+
+	// Instance 0 means no numbering
+	nextNum := max(maxDocxID(docxNumPattern, numbering), 0)
+	nextAbstract := maxDocxID(docxAbstractNumPattern, numbering)
+	numIDs := make(map[string]string)
+	abstractIDs := make(map[string]string)
+
+	var abstractDefs, numDefs strings.Builder
+	for _, match := range docxNumRefPattern.FindAllStringSubmatch(body+styles, -1) {
+		id := match[1]
+		num, ok := nums[id]
+		if _, done := numIDs[id]; done || !ok {
+			continue
+		}
+
+		ref := docxAbstractRefPattern.FindStringSubmatch(num)
+		if ref == nil || abstracts[ref[1]] == "" {
+			continue
+		}
+
+		abstractID, ok := abstractIDs[ref[1]]
+		if !ok {
+			nextAbstract++
+			abstractID = strconv.Itoa(nextAbstract)
+			abstractIDs[ref[1]] = abstractID
+			abstractDefs.WriteString(strings.Replace(abstracts[ref[1]],
+				`w:abstractNumId="`+ref[1]+`"`, `w:abstractNumId="`+abstractID+`"`, 1))
+		}
+
+		nextNum++
+		numIDs[id] = strconv.Itoa(nextNum)
+		num = strings.Replace(num, `w:numId="`+id+`"`, `w:numId="`+numIDs[id]+`"`, 1)
+		numDefs.WriteString(strings.Replace(num, ref[0], `<w:abstractNumId w:val="`+abstractID+`"`, 1))
+	}
+
+	if len(numIDs) == 0 {
+		return body, styles
+	}
+
+	renumber := func(content string) string {
+		return docxNumRefPattern.ReplaceAllStringFunc(content, func(ref string) string {
+			id := docxNumRefPattern.FindStringSubmatch(ref)[1]
+			if newID, ok := numIDs[id]; ok {
+				return `<w:numId w:val="` + newID + `"`
+			}
+
+			return ref
+		})
+	}
+
+	if m.ref.numberingPart != "" {
+		m.numbering = func(part string) string {
+			return insertNumbering(part, abstractDefs.String(), numDefs.String())
+		}
+	} else {
+		root := docxNumberingPattern.FindString(source)
+		if root == "" {
+			root = `<w:numbering xmlns:w="` + docxNamespaceMain + `">`
+		}
+
+		content := xml.Header + root + abstractDefs.String() + numDefs.String() + "</w:numbering>"
+		target := m.addPart(path.Join(path.Dir(m.ref.documentPart), path.Base(docxNumberingPart)),
+			[]byte(content), docxNumberingContentType)
+		m.addRelationship(docxRelationship{Type: docxNumberingRelType, Target: target})
+	}
+
+	return renumber(body), renumber(styles)
+}
+
+// insertNumbering adds abstract numbering definitions after those of a
+// numbering part and numbering instances after its instances, as the schema
+// orders them.
+func insertNumbering(numbering, abstracts, nums string) string {
+	end := strings.LastIndex(numbering, "</w:numbering>")
+	if end < 0 {
+		return numbering
+	}
+	if i := strings.Index(numbering, "<w:numIdMacAtCleanup"); i >= 0 {
+		end = i
+	}
+
+	at := end
+	if loc := docxNumPattern.FindStringIndex(numbering); loc != nil {
+		at = loc[0]
+	}
+
+	return numbering[:at] + abstracts + numbering[at:end] + nums + numbering[end:]
+}
+
+// bookmarks renumbers the bookmarks of body after those of the reference
+// document.
+func (m *docxMerge) bookmarks(body string) string {
+	offset := maxDocxID(docxBookmarkIDPattern, m.ref.document) + 1
+	if offset == 0 {
+		return body
+	}
+
+	return docxBookmarkIDPattern.ReplaceAllStringFunc(body, func(attr string) string {
+		sub := docxBookmarkIDPattern.FindStringSubmatch(attr)
+		id, _ := strconv.Atoi(sub[2])
+
+		return sub[1] + strconv.Itoa(id+offset) + `"`
+	})
+}
+
+// maxDocxID returns the largest ID captured by the last group of pattern in
+// content, or -1 when there is none.
+func maxDocxID(pattern *regexp.Regexp, content string) int {
+	highest := -1
+	for _, match := range pattern.FindAllStringSubmatch(content, -1) {
+		if id, err := strconv.Atoi(match[len(match)-1]); err == nil && id > highest {
+			highest = id
+		}
+	}
+
+	return highest
+}
+
+// resolveDocxTarget returns the part name of an internal relationship target.
+func resolveDocxTarget(sourcePart, target string) string {
+	if strings.HasPrefix(target, "/") {
+		return strings.TrimPrefix(target, "/")
+	}
+
+	return path.Join(path.Dir(sourcePart), target)
+}
+
+func xmlEscape(s string) string {
+	var b strings.Builder
+	_ = xml.EscapeText(&b, []byte(s))
+
+	return b.String()
+}
@@ -0,0 +1,318 @@
+package converters
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const (
+	docxTemplateContentType = "application/vnd.openxmlformats-officedocument.wordprocessingml.template.main+xml"
+	docxDocumentContentType = "application/vnd.openxmlformats-officedocument.wordprocessingml.document.main+xml"
+	docxContentTypesPart    = "[Content_Types].xml"
+	docxRootRelsPart        = "_rels/.rels"
+)
+
+// Relationship types are matched by their last segment, which is the same in
+// the transitional and strict namespaces.
+const (
+	docxOfficeDocumentRel = "officeDocument"
+	docxStylesRel         = "styles"
+	docxNumberingRel      = "numbering"
+)
+
+// docxReference is a user-supplied .docx or .dotx document whose styles,
+// headers, footers and body content (such as a cover page) are reused.
+type docxReference struct {
+	data          []byte
+	files         []string // Part names of the package
+	documentPart  string
+	relsPart      string
+	stylesPart    string
+	numberingPart string
+	document      string
+	body          docxBodyBounds
+	sectPr        *docxSectPr // Final section properties, nil when there are none
+}
+
+// loadDocxReference reads a reference document and locates its main parts.
+func loadDocxReference(fileName string) (*docxReference, error) {
+	data, err := os.ReadFile(filepath.Clean(fileName))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read reference document: %w", err)
+	}
+
+	parts, err := readDocxParts(data, docxRootRelsPart)
+	if err != nil {
+		return nil, fmt.Errorf("invalid reference document %s: %w", fileName, err)
+	}
+
+	var rootRels docxRelationships
+	if err := unmarshalDocxPart(parts[docxRootRelsPart], &rootRels); err != nil {
+		return nil, fmt.Errorf("invalid reference document %s: %w", fileName, err)
+	}
+
+	documentPart := strings.TrimPrefix(rootRels.target(docxOfficeDocumentRel), "/")
+	if documentPart == "" {
+		return nil, fmt.Errorf("invalid reference document %s: main document part not found", fileName)
+	}
+
+	relsPart := path.Join(path.Dir(documentPart), "_rels", path.Base(documentPart)+".rels")
+	parts, err = readDocxParts(data, documentPart, relsPart)
+	if err != nil {
+		return nil, fmt.Errorf("invalid reference document %s: %w", fileName, err)
+	}
+
+	var rels docxRelationships
+	if err := unmarshalDocxPart(parts[relsPart], &rels); err != nil {
+		return nil, fmt.Errorf("invalid reference document %s: %w", fileName, err)
+	}
+
+	body, sectPr, err := findDocxBody(parts[documentPart])
+	if err != nil {
+		return nil, fmt.Errorf("invalid reference document %s: %w", fileName, err)
+	}
+
+	files, err := docxPartNames(data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid reference document %s: %w", fileName, err)
+	}
+
+	return &docxReference{
+		data:          data,
+		files:         files,
+		documentPart:  documentPart,
+		relsPart:      relsPart,
+		stylesPart:    relPart(rels, docxStylesRel, documentPart),
+		numberingPart: relPart(rels, docxNumberingRel, documentPart),
+		document:      parts[documentPart],
+		body:          body,
+		sectPr:        sectPr,
+	}, nil
+}
+
+// relPart returns the part a document relationship of the given type
+// targets, or "".
+func relPart(rels docxRelationships, relType, documentPart string) string {
+	target := rels.target(relType)
+	if target == "" {
+		return ""
+	}
+
+	return resolveDocxTarget(documentPart, target)
+}
+
+// docxPartNames returns the names of the parts of a package.
+func docxPartNames(data []byte) ([]string, error) {
+	reader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read document package: %w", err)
+	}
+
+	names := make([]string, 0, len(reader.File))
+	for _, file := range reader.File {
+		names = append(names, file.Name)
+	}
+
+	return names, nil
+}
+
+// readDocxParts returns the contents of the named parts of a package.
+func readDocxParts(data []byte, names ...string) (map[string]string, error) {
+	reader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read document package: %w", err)
+	}
+
+	wanted := make(map[string]bool, len(names))
+	for _, name := range names {
+		wanted[name] = true
+	}
+
+	parts := make(map[string]string, len(names))
+	for _, file := range reader.File {
+		if !wanted[file.Name] {
+			continue
+		}
+
+		content, err := readZipFile(file)
+		if err != nil {
+			return nil, err
+		}
+		parts[file.Name] = string(content)
+	}
+
+	return parts, nil
+}
+
+// textWidth returns the width between the page margins of the reference
+// document's last section in twips.
+func (r *docxReference) textWidth() int {
+	if r.sectPr == nil {
+		return docxDefaultTextWidth
+	}
+
+	width, err := strconv.Atoi(r.sectPr.PageSize.Width)
+	if err != nil {
+		return docxDefaultTextWidth
+	}
+
+	// Margins that are missing or not in twips count as zero
+	left, _ := strconv.Atoi(r.sectPr.Margins.Left)
+	right, _ := strconv.Atoi(r.sectPr.Margins.Right)
+	if width -= left + right; width <= 0 {
+		return docxDefaultTextWidth
+	}
+
+	return width
+}
+
+// apply writes the reference package to output with the generated body
+// appended to its own body content. The styles, numbering definitions,
+// relationships and parts the generated body uses are carried over with
+// renumbered IDs, and a template main part is turned into a regular document.
+func (r *docxReference) apply(generated []byte, output io.Writer) error {
+	parts, err := readDocxParts(generated, docxDocumentPart, docxStylesPart, docxNumberingPart,
+		docxDocumentRelsPart, docxContentTypesPart)
+	if err != nil {
+		return err
+	}
+	own, err := readDocxParts(r.data, r.relsPart, r.stylesPart, r.numberingPart, docxContentTypesPart)
+	if err != nil {
+		return err
+	}
+
+	merge, err := newDocxMerge(r, generated, parts, own)
+	if err != nil {
+		return err
+	}
+
+	document := parts[docxDocumentPart]
+	bounds, _, err := findDocxBody(document)
+	if err != nil {
+		return err
+	}
+
+	body := document[bounds.start:bounds.sectPr]
+	styles := ""
+	if r.stylesPart != "" {
+		styles = missingDocxStyles(own[r.stylesPart], parts[docxStylesPart], body)
+	}
+
+	body, styles = merge.mergeNumbering(body, styles, own[r.numberingPart])
+	body = merge.bookmarks(body)
+	if body, err = merge.relationships(body); err != nil {
+		return err
+	}
+
+	rewrites := map[string]func(string) string{
+		r.documentPart: func(string) string {
+			return r.document[:r.body.sectPr] + body + r.document[r.body.sectPr:]
+		},
+		docxContentTypesPart: func(xml string) string {
+			xml = strings.ReplaceAll(xml, docxTemplateContentType, docxDocumentContentType)
+			return strings.Replace(xml, "</Types>", merge.overrides.String()+"</Types>", 1)
+		},
+		path.Join(path.Dir(r.documentPart), "settings.xml"): enableUpdateFields,
+	}
+	if r.stylesPart != "" {
+		rewrites[r.stylesPart] = func(xml string) string {
+			return ensureDocxStyles(strings.Replace(xml, "</w:styles>", styles+"</w:styles>", 1))
+		}
+	}
+	if merge.numbering != nil {
+		rewrites[r.numberingPart] = merge.numbering
+	}
+	if merge.rels.Len() > 0 {
+		if own[r.relsPart] == "" {
+			merge.added[r.relsPart] = []byte(xml.Header + `<Relationships xmlns="` + docxNamespaceRelsPackage + `">` +
+				merge.rels.String() + "</Relationships>")
+		} else {
+			rewrites[r.relsPart] = func(xml string) string {
+				return strings.Replace(xml, "</Relationships>", merge.rels.String()+"</Relationships>", 1)
+			}
+		}
+	}
+
+	return rewriteDocxParts(r.data, rewrites, merge.added, output)
+}
+
+// docxBodyBounds are the offsets in a document part where the content of
+// its body starts and ends, and where its final section properties start;
+// sectPr is end when there are none.
+type docxBodyBounds struct {
+	start, sectPr, end int
+}
+
+// docxSectPr holds the section properties the converter reads.
+type docxSectPr struct {
+	PageSize struct {
+		Width string `xml:"w,attr"`
+	} `xml:"pgSz"`
+	Margins struct {
+		Left  string `xml:"left,attr"`
+		Right string `xml:"right,attr"`
+	} `xml:"pgMar"`
+}
+
+// findDocxBody locates the body of a document part and decodes its final
+// section properties. Section properties of paragraphs, inside their w:pPr,
+// are not body children and are skipped.
+func findDocxBody(document string) (docxBodyBounds, *docxSectPr, error) {
+	d := xml.NewDecoder(strings.NewReader(document))
+
+	bounds := docxBodyBounds{start: -1}
+	var sectPr *docxSectPr
+	depth := 0 // Of the current element below the body
+
+	for {
+		offset := int(d.InputOffset())
+		token, err := d.Token()
+		if errors.Is(err, io.EOF) {
+			return bounds, nil, errors.New("document body not found")
+		}
+		if err != nil {
+			return bounds, nil, fmt.Errorf("failed to parse document part: %w", err)
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch {
+			case bounds.start < 0:
+				if t.Name.Space == docxNamespaceMain && t.Name.Local == "body" {
+					bounds.start = int(d.InputOffset())
+				}
+			case depth == 0 && t.Name.Space == docxNamespaceMain && t.Name.Local == "sectPr":
+				sectPr = &docxSectPr{}
+				if err := d.DecodeElement(sectPr, &t); err != nil {
+					return bounds, nil, fmt.Errorf("failed to parse section properties: %w", err)
+				}
+				bounds.sectPr = offset
+			default:
+				depth++
+				sectPr = nil
+			}
+		case xml.EndElement:
+			switch {
+			case bounds.start < 0:
+			case depth > 0:
+				depth--
+			default:
+				bounds.end = offset
+				if sectPr == nil {
+					bounds.sectPr = offset
+				}
+
+				return bounds, sectPr, nil
+			}
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package converters
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFindDocxBody(t *testing.T) {
+	const (
+		header   = `<?xml version="1.0"?><w:document xmlns:w="` + docxNamespaceMain + `">`
+		pageSize = `<w:pgSz w:w="11906" w:h="16838"/><w:pgMar w:left="1440" w:right="1000"/>`
+	)
+
+	tests := []struct {
+		name     string
+		document string
+		content  string // Body content ahead of the final section properties
+		sectPr   string
+		width    int
+		wantErr  string
+	}{
+		{
+			name:     "final section properties",
+			document: header + `<w:body><w:p/><w:sectPr>` + pageSize + `</w:sectPr></w:body></w:document>`,
+			content:  `<w:p/>`,
+			sectPr:   `<w:sectPr>` + pageSize + `</w:sectPr>`,
+			width:    9466,
+		},
+		{
+			name: "paragraph section properties",
+			document: header + `<w:body><w:p><w:pPr><w:sectPr>` + pageSize + `</w:sectPr></w:pPr></w:p>` +
+				`<w:p/></w:body></w:document>`,
+			content: `<w:p><w:pPr><w:sectPr>` + pageSize + `</w:sectPr></w:pPr></w:p><w:p/>`,
+			width:   docxDefaultTextWidth,
+		},
+		{
+			name:     "section properties ahead of content",
+			document: header + `<w:body><w:sectPr/><w:p/></w:body></w:document>`,
+			content:  `<w:sectPr/><w:p/>`,
+			width:    docxDefaultTextWidth,
+		},
+		{
+			name: "other prefix",
+			document: `<doc:document xmlns:doc="` + docxNamespaceMain + `"><doc:body><doc:p/><doc:sectPr>` +
+				`<doc:pgSz doc:w="12240"/></doc:sectPr></doc:body></doc:document>`,
+			content: `<doc:p/>`,
+			sectPr:  `<doc:sectPr><doc:pgSz doc:w="12240"/></doc:sectPr>`,
+			width:   12240,
+		},
+		{
+			name:     "size in other units",
+			document: header + `<w:body><w:sectPr><w:pgSz w:w="21cm"/></w:sectPr></w:body></w:document>`,
+			sectPr:   `<w:sectPr><w:pgSz w:w="21cm"/></w:sectPr>`,
+			width:    docxDefaultTextWidth,
+		},
+		{
+			name:     "empty body",
+			document: header + `<w:body/></w:document>`,
+			width:    docxDefaultTextWidth,
+		},
+		{
+			name:     "no body",
+			document: header + `</w:document>`,
+			wantErr:  "document body not found",
+		},
+		{
+			name:     "invalid XML",
+			document: header + `<w:body><w:p></w:body></w:document>`,
+			wantErr:  "failed to parse document part",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bounds, sectPr, err := findDocxBody(tt.document)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("findDocxBody() error = %v, want it to contain %q", err, tt.wantErr)
+				}
+
+				return
+			}
+			if err != nil {
+				t.Fatalf("findDocxBody() error = %v", err)
+			}
+
+			if got := tt.document[bounds.start:bounds.sectPr]; got != tt.content {
+				t.Errorf("content = %q, want %q", got, tt.content)
+			}
+			if got := tt.document[bounds.sectPr:bounds.end]; got != tt.sectPr {
+				t.Errorf("section properties = %q, want %q", got, tt.sectPr)
+			}
+
+			ref := &docxReference{sectPr: sectPr}
+			if got := ref.textWidth(); got != tt.width {
+				t.Errorf("textWidth() = %d, want %d", got, tt.width)
+			}
+		})
+	}
+}
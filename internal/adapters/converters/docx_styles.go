@@ -0,0 +1,119 @@
+package converters
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Named styles for every element the DOCX converter emits. A reference
+// document can restyle the output by defining styles with these IDs; the
+// built-in Word styles are used where one fits.
+const (
+	docxStyleSubtitle   = "Subtitle"
+	docxStyleTOCHeading = "TOCHeading"
+	docxStyleCaption    = "Caption"
+	docxStyleQuote      = "Quote"
+	docxStyleBullet     = "ListBullet"
+	docxStyleNumber     = "ListNumber"
+	docxStyleSourceCode = "SourceCode"
+	docxStyleVerbatim   = "VerbatimChar"
+	docxStyleHyperlink  = "Hyperlink"
+	docxStyleTable      = "Table"
+)
+
+// docxStyleDefinitions are the default definitions of the custom styles,
+// added to styles.xml when the template does not define them.
+var docxStyleDefinitions = map[string]string{
+	docxStyleSourceCode: `<w:style w:type="paragraph" w:customStyle="1" w:styleId="SourceCode">` +
+		`<w:name w:val="Source Code"/><w:basedOn w:val="Normal"/><w:qFormat/>` +
+		`<w:pPr><w:shd w:val="clear" w:color="auto" w:fill="F5F5F5"/><w:spacing w:before="0" w:after="0" w:line="240" w:lineRule="auto"/></w:pPr>` +
+		`<w:rPr><w:rFonts w:ascii="Courier New" w:hAnsi="Courier New" w:cs="Courier New"/><w:sz w:val="18"/></w:rPr></w:style>`,
+
+	docxStyleVerbatim: `<w:style w:type="character" w:customStyle="1" w:styleId="VerbatimChar">` +
+		`<w:name w:val="Verbatim Char"/><w:qFormat/>` +
+		`<w:rPr><w:rFonts w:ascii="Courier New" w:hAnsi="Courier New" w:cs="Courier New"/></w:rPr></w:style>`,
+
+	docxStyleHyperlink: `<w:style w:type="character" w:styleId="Hyperlink">` +
+		`<w:name w:val="Hyperlink"/><w:uiPriority w:val="99"/><w:unhideWhenUsed/>` +
+		`<w:rPr><w:color w:val="0563C1"/><w:u w:val="single"/></w:rPr></w:style>`,
+
+	docxStyleTable: `<w:style w:type="table" w:customStyle="1" w:styleId="Table">` +
+		`<w:name w:val="Table"/><w:basedOn w:val="TableGrid"/><w:qFormat/>` +
+		`<w:pPr><w:spacing w:before="0" w:after="0"/></w:pPr>` +
+		`<w:tblStylePr w:type="firstRow"><w:rPr><w:b/></w:rPr>` +
+		`<w:tcPr><w:shd w:val="clear" w:color="auto" w:fill="E7E6E6"/></w:tcPr></w:tblStylePr></w:style>`,
+}
+
+// ensureDocxStyles adds the default definitions of custom styles that are
+// missing from a styles part.
+func ensureDocxStyles(styles string) string {
+	var missing strings.Builder
+
+	for _, id := range sortedKeys(docxStyleDefinitions) {
+		if !hasDocxStyle(styles, id) {
+			missing.WriteString(docxStyleDefinitions[id])
+		}
+	}
+
+	return strings.Replace(styles, "</w:styles>", missing.String()+"</w:styles>", 1)
+}
+
+func hasDocxStyle(styles, id string) bool {
+	return strings.Contains(styles, fmt.Sprintf(`w:styleId="%s"`, id))
+}
+
+var (
+	docxStyleRefPattern   = regexp.MustCompile(`<w:(?:pStyle|rStyle|tblStyle) w:val="([^"]+)"`)
+	docxStyleBasedPattern = regexp.MustCompile(`<w:basedOn w:val="([^"]+)"`)
+)
+
+// missingDocxStyles returns the definitions from source of every style
+// referenced by document that target lacks, following basedOn chains.
+func missingDocxStyles(target, source, document string) string {
+	var pending []string
+	for _, m := range docxStyleRefPattern.FindAllStringSubmatch(document, -1) {
+		pending = append(pending, m[1])
+	}
+
+	seen := make(map[string]bool)
+	var missing strings.Builder
+
+	for len(pending) > 0 {
+		id := pending[0]
+		pending = pending[1:]
+
+		if seen[id] || hasDocxStyle(target, id) {
+			continue
+		}
+		seen[id] = true
+
+		def := findDocxStyle(source, id)
+		if def == "" {
+			continue
+		}
+		missing.WriteString(def)
+
+		if m := docxStyleBasedPattern.FindStringSubmatch(def); m != nil {
+			pending = append(pending, m[1])
+		}
+	}
+
+	return missing.String()
+}
+
+// findDocxStyle returns the w:style element with the given ID, or "".
+func findDocxStyle(styles, id string) string {
+	idx := strings.Index(styles, fmt.Sprintf(`w:styleId="%s"`, id))
+	if idx < 0 {
+		return ""
+	}
+
+	start := strings.LastIndex(styles[:idx], "<w:style ")
+	end := strings.Index(styles[idx:], "</w:style>")
+	if start < 0 || end < 0 {
+		return ""
+	}
+
+	return styles[start : idx+end+len("</w:style>")]
+}
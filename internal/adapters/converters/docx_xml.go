@@ -0,0 +1,491 @@
+package converters
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/gomutex/godocx/docx"
+	"github.com/gomutex/godocx/wml/ctypes"
+	"github.com/gomutex/godocx/wml/stypes"
+)
+
+// godocx has no API for bookmarks, internal hyperlinks, fields, or table
+// grid, row and cell properties. The converter builds paragraphs with godocx
+// and keeps the other elements in a docxBody, which encodes the main document
+// part from both with encoding/xml, using the godocx element types where they
+// exist.
+
+const (
+	docxDocumentPart = "word/document.xml"
+	docxSettingsPart = "word/settings.xml"
+	docxStylesPart   = "word/styles.xml"
+
+	docxNamespaceMain = "http://schemas.openxmlformats.org/wordprocessingml/2006/main"
+	docxNamespaceRels = "http://schemas.openxmlformats.org/officeDocument/2006/relationships"
+
+	// docxDefaultTextWidth is the text width in twips of the default template
+	// (Letter paper with 1.25" side margins), used when the section has no size.
+	docxDefaultTextWidth = 8640
+)
+
+// docxBody holds the elements of the document body godocx cannot create,
+// positioned relative to the godocx body children.
+type docxBody struct {
+	blocks    map[int][]xml.Marshaler              // Elements placed before the body child at an index
+	bookmarks map[*ctypes.Paragraph][]docxBookmark // Bookmarks at the start of body paragraphs
+	defined   map[string]bool                      // Names of the bookmarks, for links to check
+}
+
+func newDocxBody() *docxBody {
+	return &docxBody{
+		blocks:    make(map[int][]xml.Marshaler),
+		bookmarks: make(map[*ctypes.Paragraph][]docxBookmark),
+		defined:   make(map[string]bool),
+	}
+}
+
+// add appends an element to the end of the document body.
+func (b *docxBody) add(document *docx.RootDoc, block xml.Marshaler) {
+	at := len(document.Document.Body.Children)
+	b.blocks[at] = append(b.blocks[at], block)
+}
+
+// bookmark marks a paragraph as the target of internal links to name.
+func (b *docxBody) bookmark(p *docx.Paragraph, name string) {
+	ct := p.GetCT()
+	b.bookmarks[ct] = append(b.bookmarks[ct], docxBookmark{ID: len(b.defined), Name: name})
+	b.defined[name] = true
+}
+
+// encode returns the main document part.
+func (b *docxBody) encode(document *docx.RootDoc) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+
+	e := xml.NewEncoder(&buf)
+	root := xml.StartElement{
+		Name: xml.Name{Local: "w:document"},
+		Attr: []xml.Attr{
+			{Name: xml.Name{Local: "xmlns:w"}, Value: docxNamespaceMain},
+			{Name: xml.Name{Local: "xmlns:r"}, Value: docxNamespaceRels},
+		},
+	}
+	body := xml.StartElement{Name: xml.Name{Local: "w:body"}}
+
+	if err := encodeTokens(e, root, body); err != nil {
+		return nil, err
+	}
+
+	// The converter adds no godocx tables, so the children are paragraphs
+	children := document.Document.Body.Children
+	for i, child := range children {
+		if err := b.encodeBlocks(e, i); err != nil {
+			return nil, err
+		}
+
+		if child.Para != nil {
+			ct := child.Para.GetCT()
+			if err := (docxParagraph{ct: ct, bookmarks: b.bookmarks[ct]}).MarshalXML(e, xml.StartElement{}); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err := b.encodeBlocks(e, len(children)); err != nil {
+		return nil, err
+	}
+
+	if sect := document.Document.Body.SectPr; sect != nil {
+		if err := sect.MarshalXML(e, xml.StartElement{}); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := encodeTokens(e, body.End(), root.End()); err != nil {
+		return nil, err
+	}
+	if err := e.Flush(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (b *docxBody) encodeBlocks(e *xml.Encoder, at int) error {
+	for _, block := range b.blocks[at] {
+		if err := block.MarshalXML(e, xml.StartElement{}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func encodeTokens(e *xml.Encoder, tokens ...xml.Token) error {
+	for _, token := range tokens {
+		if err := e.EncodeToken(token); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// docxBookmark is a bookmark around the start of a paragraph.
+type docxBookmark struct {
+	ID   int
+	Name string
+}
+
+func (m docxBookmark) MarshalXML(e *xml.Encoder, _ xml.StartElement) error {
+	id := xml.Attr{Name: xml.Name{Local: "w:id"}, Value: strconv.Itoa(m.ID)}
+	start := xml.StartElement{
+		Name: xml.Name{Local: "w:bookmarkStart"},
+		Attr: []xml.Attr{id, {Name: xml.Name{Local: "w:name"}, Value: m.Name}},
+	}
+	end := xml.StartElement{Name: xml.Name{Local: "w:bookmarkEnd"}, Attr: []xml.Attr{id}}
+
+	return encodeTokens(e, start, start.End(), end, end.End())
+}
+
+// docxParagraph encodes a godocx paragraph with bookmarks at its start.
+type docxParagraph struct {
+	ct        *ctypes.Paragraph
+	bookmarks []docxBookmark
+}
+
+func (p docxParagraph) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if len(p.bookmarks) == 0 {
+		return p.ct.MarshalXML(e, start)
+	}
+
+	start = xml.StartElement{Name: xml.Name{Local: "w:p"}}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+
+	if p.ct.Property != nil {
+		if err := p.ct.Property.MarshalXML(e, xml.StartElement{}); err != nil {
+			return err
+		}
+	}
+
+	for _, bookmark := range p.bookmarks {
+		if err := bookmark.MarshalXML(e, xml.StartElement{}); err != nil {
+			return err
+		}
+	}
+
+	for _, child := range p.ct.Children {
+		if child.Run != nil {
+			if err := child.Run.MarshalXML(e, xml.StartElement{}); err != nil {
+				return err
+			}
+		}
+		if child.Link != nil {
+			if err := e.EncodeElement(child.Link, xml.StartElement{Name: xml.Name{Local: "w:hyperlink"}}); err != nil {
+				return err
+			}
+		}
+	}
+
+	return e.EncodeToken(start.End())
+}
+
+// docxCell is the text of a table cell, linking to a bookmark when anchor is
+// set.
+type docxCell struct {
+	text   string
+	anchor string
+}
+
+// docxTable is a table in the table style with fixed column widths, whose
+// first row is a header repeated on every page.
+type docxTable struct {
+	widths  []int // Column widths in twips
+	rows    [][]docxCell
+	defined map[string]bool // Bookmark names links can point to
+}
+
+func (t docxTable) MarshalXML(e *xml.Encoder, _ xml.StartElement) error {
+	total := 0
+	grid := ctypes.Grid{Col: make([]ctypes.Column, len(t.widths))}
+	for i, w := range t.widths {
+		total += w
+		width := uint64(w)
+		grid.Col[i].Width = &width
+	}
+
+	props := ctypes.TableProp{
+		Style:     ctypes.NewCTString(docxStyleTable),
+		Width:     ctypes.NewTableWidth(total, stypes.TableWidthDxa),
+		Layout:    ctypes.NewTableLayout(stypes.TableLayoutFixed),
+		TableLook: ctypes.NewCTString("04A0"),
+	}
+
+	start := xml.StartElement{Name: xml.Name{Local: "w:tbl"}}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := props.MarshalXML(e, xml.StartElement{}); err != nil {
+		return err
+	}
+	if err := grid.MarshalXML(e, xml.StartElement{}); err != nil {
+		return err
+	}
+
+	for i, row := range t.rows {
+		rowProps := ctypes.DefaultRowProperty()
+		if i == 0 {
+			// Shaded through the first-row formatting of the table style
+			rowProps.CantSplit = &ctypes.OnOff{}
+			rowProps.Header = &ctypes.OnOff{}
+		}
+
+		if err := t.encodeRow(e, rowProps, row); err != nil {
+			return err
+		}
+	}
+
+	return e.EncodeToken(start.End())
+}
+
+func (t docxTable) encodeRow(e *xml.Encoder, props *ctypes.RowProperty, cells []docxCell) error {
+	row := xml.StartElement{Name: xml.Name{Local: "w:tr"}}
+	if err := e.EncodeToken(row); err != nil {
+		return err
+	}
+	if err := props.MarshalXML(e, xml.StartElement{}); err != nil {
+		return err
+	}
+
+	for i, cell := range cells {
+		tc := xml.StartElement{Name: xml.Name{Local: "w:tc"}}
+		if err := e.EncodeToken(tc); err != nil {
+			return err
+		}
+
+		if i < len(t.widths) {
+			cellProps := ctypes.CellProperty{Width: ctypes.NewTableWidth(t.widths[i], stypes.TableWidthDxa)}
+			if err := cellProps.MarshalXML(e, xml.StartElement{}); err != nil {
+				return err
+			}
+		}
+
+		p := xml.StartElement{Name: xml.Name{Local: "w:p"}}
+		if err := e.EncodeToken(p); err != nil {
+			return err
+		}
+		if err := t.encodeCellText(e, cell); err != nil {
+			return err
+		}
+		if err := encodeTokens(e, p.End(), tc.End()); err != nil {
+			return err
+		}
+	}
+
+	return e.EncodeToken(row.End())
+}
+
+// encodeCellText writes the text of a cell, as a hyperlink when its bookmark
+// exists and as plain text otherwise.
+func (t docxTable) encodeCellText(e *xml.Encoder, cell docxCell) error {
+	if cell.text == "" {
+		return nil
+	}
+
+	run := ctypes.Run{Children: []ctypes.RunChild{{Text: ctypes.TextFromString(cell.text)}}}
+	if cell.anchor == "" || !t.defined[cell.anchor] {
+		return run.MarshalXML(e, xml.StartElement{})
+	}
+
+	run.Property = &ctypes.RunProperty{Style: ctypes.NewRunStyle(docxStyleHyperlink)}
+
+	link := xml.StartElement{
+		Name: xml.Name{Local: "w:hyperlink"},
+		Attr: []xml.Attr{
+			{Name: xml.Name{Local: "w:anchor"}, Value: cell.anchor},
+			{Name: xml.Name{Local: "w:history"}, Value: "1"},
+		},
+	}
+	if err := e.EncodeToken(link); err != nil {
+		return err
+	}
+	if err := run.MarshalXML(e, xml.StartElement{}); err != nil {
+		return err
+	}
+
+	return e.EncodeToken(link.End())
+}
+
+// docxTOCField is a paragraph holding a TOC field over heading levels 1-3
+// with hyperlinked entries. The field is marked dirty so Word rebuilds it on
+// open.
+type docxTOCField struct{}
+
+func (docxTOCField) MarshalXML(e *xml.Encoder, _ xml.StartElement) error {
+	instr := ctypes.Run{Children: []ctypes.RunChild{{InstrText: ctypes.TextFromString(` TOC \o "1-3" \h \z \u `)}}}
+	text := ctypes.Run{Children: []ctypes.RunChild{{Text: ctypes.TextFromString("Update this field to build the table of contents.")}}}
+
+	p := xml.StartElement{Name: xml.Name{Local: "w:p"}}
+	if err := e.EncodeToken(p); err != nil {
+		return err
+	}
+	if err := encodeFieldChar(e, "begin", true); err != nil {
+		return err
+	}
+	if err := instr.MarshalXML(e, xml.StartElement{}); err != nil {
+		return err
+	}
+	if err := encodeFieldChar(e, "separate", false); err != nil {
+		return err
+	}
+	if err := text.MarshalXML(e, xml.StartElement{}); err != nil {
+		return err
+	}
+	if err := encodeFieldChar(e, "end", false); err != nil {
+		return err
+	}
+
+	return e.EncodeToken(p.End())
+}
+
+// encodeFieldChar writes a run holding a field character of the given type.
+func encodeFieldChar(e *xml.Encoder, fieldType string, dirty bool) error {
+	run := xml.StartElement{Name: xml.Name{Local: "w:r"}}
+	char := xml.StartElement{
+		Name: xml.Name{Local: "w:fldChar"},
+		Attr: []xml.Attr{{Name: xml.Name{Local: "w:fldCharType"}, Value: fieldType}},
+	}
+	if dirty {
+		char.Attr = append(char.Attr, xml.Attr{Name: xml.Name{Local: "w:dirty"}, Value: "true"})
+	}
+
+	return encodeTokens(e, run, char, char.End(), run.End())
+}
+
+// rewriteDocxParts copies a DOCX package to output, passing the parts named
+// in rewrites through their rewrite functions and adding the parts in added.
+func rewriteDocxParts(src []byte, rewrites map[string]func(string) string, added map[string][]byte,
+	output io.Writer,
+) error {
+	reader, err := zip.NewReader(bytes.NewReader(src), int64(len(src)))
+	if err != nil {
+		return fmt.Errorf("failed to read document package: %w", err)
+	}
+
+	writer := zip.NewWriter(output)
+
+	for _, file := range reader.File {
+		rewrite, ok := rewrites[file.Name]
+		if !ok {
+			if err := writer.Copy(file); err != nil {
+				return fmt.Errorf("failed to copy %s: %w", file.Name, err)
+			}
+			continue
+		}
+
+		content, err := readZipFile(file)
+		if err != nil {
+			return err
+		}
+
+		w, err := writer.CreateHeader(&zip.FileHeader{Name: file.Name, Method: zip.Deflate})
+		if err != nil {
+			return fmt.Errorf("failed to create %s: %w", file.Name, err)
+		}
+		if _, err := io.WriteString(w, rewrite(string(content))); err != nil {
+			return fmt.Errorf("failed to write %s: %w", file.Name, err)
+		}
+	}
+
+	for _, name := range sortedKeys(added) {
+		w, err := writer.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Deflate})
+		if err != nil {
+			return fmt.Errorf("failed to create %s: %w", name, err)
+		}
+		if _, err := w.Write(added[name]); err != nil {
+			return fmt.Errorf("failed to write %s: %w", name, err)
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return fmt.Errorf("failed to finish document package: %w", err)
+	}
+
+	return nil
+}
+
+func readZipFile(file *zip.File) ([]byte, error) {
+	rc, err := file.Open()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", file.Name, err)
+	}
+	defer rc.Close()
+
+	content, err := io.ReadAll(rc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", file.Name, err)
+	}
+
+	return content, nil
+}
+
+// docxTextWidth returns the width between the page margins in twips.
+func docxTextWidth(document *docx.RootDoc) int {
+	sect := document.Document.Body.SectPr
+	if sect == nil || sect.PageSize == nil || sect.PageSize.Width == nil || sect.PageMargin == nil {
+		return docxDefaultTextWidth
+	}
+
+	width := int(*sect.PageSize.Width)
+	if sect.PageMargin.Left != nil {
+		width -= *sect.PageMargin.Left
+	}
+	if sect.PageMargin.Right != nil {
+		width -= *sect.PageMargin.Right
+	}
+	if width <= 0 {
+		return docxDefaultTextWidth
+	}
+
+	return width
+}
+
+// scaleTwips distributes a text width over relative column widths.
+func scaleTwips(widths []float64, textWidth int) []int {
+	var total float64
+	for _, w := range widths {
+		total += w
+	}
+
+	cols := make([]int, len(widths))
+	for i, w := range widths {
+		cols[i] = int(w / total * float64(textWidth))
+	}
+
+	return cols
+}
+
+// enableUpdateFields asks Word to refresh fields such as the TOC when the
+// document is opened. The element must precede the compatibility settings.
+func enableUpdateFields(settings string) string {
+	if strings.Contains(settings, "<w:updateFields") {
+		return settings
+	}
+
+	const updateFields = `<w:updateFields w:val="true"/>`
+
+	for _, next := range []string{"<w:hdrShapeDefaults", "<w:footnotePr", "<w:endnotePr", "<w:compat", "<w:docVars", "<w:rsids"} {
+		if i := strings.Index(settings, next); i >= 0 {
+			return settings[:i] + updateFields + settings[i:]
+		}
+	}
+
+	return strings.Replace(settings, "</w:settings>", updateFields+"</w:settings>", 1)
+}
@@ -0,0 +1,530 @@
+package converters
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/url"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/GabrielNunesIT/openapi-converter/internal/domain"
+	"gopkg.in/yaml.v3"
+)
+
+// Example languages, named after the identifiers code highlighters use.
+const (
+	exampleJSON = "json"
+	exampleYAML = "yaml"
+	exampleXML  = "xml"
+	exampleForm = "form"
+	exampleText = "text"
+)
+
+// tokenKind classifies a highlighted token of an example.
+type tokenKind int
+
+const (
+	tokenPlain tokenKind = iota
+	tokenKey
+	tokenString
+	tokenNumber
+	tokenLiteral // true, false, null
+	tokenPunct
+	tokenTag
+	tokenAttr
+	tokenComment
+)
+
+// exampleToken is a highlighted fragment of a single example line.
+type exampleToken struct {
+	Kind tokenKind
+	Text string
+}
+
+// tokenColors holds the RGB color of each token kind, shared by all converters.
+var tokenColors = map[tokenKind][3]int{
+	tokenPlain:   {36, 41, 46},
+	tokenKey:     {0, 92, 197},
+	tokenString:  {3, 47, 98},
+	tokenNumber:  {0, 134, 114},
+	tokenLiteral: {215, 58, 73},
+	tokenPunct:   {106, 115, 125},
+	tokenTag:     {34, 134, 58},
+	tokenAttr:    {111, 66, 193},
+	tokenComment: {106, 115, 125},
+}
+
+// tokenHexColor returns the color of a token kind as an RRGGBB string.
+func tokenHexColor(kind tokenKind) string {
+	c := tokenColors[kind]
+
+	return fmt.Sprintf("%02X%02X%02X", c[0], c[1], c[2])
+}
+
+// namedExample is an example payload together with its display title.
+type namedExample struct {
+	title     string
+	mediaType string
+	value     interface{}
+}
+
+// generatedSuffix marks the titles of examples synthesized from schemas.
+const generatedSuffix = " (generated)"
+
+// requestExamples gathers the examples of a request body, ordered by content
+// type and name. When synth is non-nil, content types without examples get
+// one synthesized from their schema.
+func requestExamples(rb *domain.RequestBody, synth *exampleSynthesizer) []namedExample {
+	if rb == nil {
+		return nil
+	}
+
+	var examples []namedExample
+
+	for _, contentType := range sortedKeys(rb.Content) {
+		media := rb.Content[contentType]
+
+		if media.Example != nil {
+			examples = append(examples, namedExample{title: contentType, mediaType: contentType, value: media.Example})
+		}
+
+		for _, name := range sortedKeys(media.Examples) {
+			examples = append(examples, namedExample{
+				title:     fmt.Sprintf("%s (%s)", contentType, name),
+				mediaType: contentType,
+				value:     media.Examples[name],
+			})
+		}
+
+		if value := synthesizeMissing(synth, media); value != nil {
+			examples = append(examples, namedExample{
+				title:     contentType + generatedSuffix,
+				mediaType: contentType,
+				value:     value,
+			})
+		}
+	}
+
+	return examples
+}
+
+// responseExamples gathers the examples of responses, ordered by status code,
+// content type and name, synthesizing missing ones like requestExamples.
+func responseExamples(responses []domain.Response, synth *exampleSynthesizer) []namedExample {
+	sorted := make([]domain.Response, len(responses))
+	copy(sorted, responses)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].StatusCode < sorted[j].StatusCode
+	})
+
+	var examples []namedExample
+
+	for _, resp := range sorted {
+		for _, mediaType := range sortedKeys(resp.Content) {
+			media := resp.Content[mediaType]
+
+			if media.Example != nil {
+				examples = append(examples, namedExample{
+					title:     fmt.Sprintf("%s - %s", resp.StatusCode, mediaType),
+					mediaType: mediaType,
+					value:     media.Example,
+				})
+			}
+
+			for _, name := range sortedKeys(media.Examples) {
+				examples = append(examples, namedExample{
+					title:     fmt.Sprintf("%s - %s (%s)", resp.StatusCode, mediaType, name),
+					mediaType: mediaType,
+					value:     media.Examples[name],
+				})
+			}
+
+			if value := synthesizeMissing(synth, media); value != nil {
+				examples = append(examples, namedExample{
+					title:     fmt.Sprintf("%s - %s%s", resp.StatusCode, mediaType, generatedSuffix),
+					mediaType: mediaType,
+					value:     value,
+				})
+			}
+		}
+	}
+
+	return examples
+}
+
+// synthesizeMissing returns a generated example for a media type that has no
+// examples of its own, or nil when synthesis is disabled or not possible.
+func synthesizeMissing(synth *exampleSynthesizer, media domain.MediaType) interface{} {
+	if synth == nil || media.Example != nil || len(media.Examples) > 0 {
+		return nil
+	}
+
+	return synth.synthesize(media.Schema)
+}
+
+// sortedKeys returns the keys of a string-keyed map in ascending order.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+// exampleLanguage maps a media type to the language used to format its examples.
+func exampleLanguage(mediaType string) string {
+	mt := strings.ToLower(strings.TrimSpace(strings.Split(mediaType, ";")[0]))
+
+	switch {
+	case mt == "application/json" || strings.HasSuffix(mt, "+json") || strings.HasSuffix(mt, "/json"):
+		return exampleJSON
+	case strings.HasSuffix(mt, "/yaml") || strings.HasSuffix(mt, "/x-yaml") || strings.HasSuffix(mt, "+yaml"):
+		return exampleYAML
+	case strings.HasSuffix(mt, "/xml") || strings.HasSuffix(mt, "+xml"):
+		return exampleXML
+	case mt == "application/x-www-form-urlencoded" || mt == "multipart/form-data":
+		return exampleForm
+	case strings.HasPrefix(mt, "text/"):
+		return exampleText
+	default:
+		return exampleJSON
+	}
+}
+
+// formatExample pretty-prints an example value according to its media type and
+// returns the formatted text along with its language.
+func formatExample(mediaType string, value interface{}) (string, string) {
+	lang := exampleLanguage(mediaType)
+	str, isString := value.(string)
+
+	switch lang {
+	case exampleJSON:
+		if isString {
+			var decoded interface{}
+			if err := json.Unmarshal([]byte(str), &decoded); err != nil {
+				return str, exampleText
+			}
+			value = decoded
+		}
+		if b, err := json.MarshalIndent(value, "", "  "); err == nil {
+			return string(b), lang
+		}
+
+	case exampleYAML:
+		if isString {
+			return strings.TrimRight(str, "\n"), lang
+		}
+		if b, err := yaml.Marshal(value); err == nil {
+			return strings.TrimRight(string(b), "\n"), lang
+		}
+
+	case exampleXML:
+		if isString {
+			if indented, err := indentXML(str); err == nil {
+				return indented, lang
+			}
+
+			return str, lang
+		}
+		// Structured values cannot be mapped to XML reliably; show them as JSON
+		return formatExample("application/json", value)
+
+	case exampleForm:
+		if fields, ok := value.(map[string]interface{}); ok {
+			return formatForm(fields), lang
+		}
+	}
+
+	if isString {
+		return str, exampleText
+	}
+
+	return fmt.Sprintf("%v", value), exampleText
+}
+
+// indentXML re-indents an XML document two spaces per level.
+func indentXML(src string) (string, error) {
+	decoder := xml.NewDecoder(strings.NewReader(src))
+	var buf bytes.Buffer
+	encoder := xml.NewEncoder(&buf)
+	encoder.Indent("", "  ")
+
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+
+		// Whitespace between elements is replaced by the encoder's indentation
+		if data, ok := token.(xml.CharData); ok && strings.TrimSpace(string(data)) == "" {
+			continue
+		}
+
+		if err := encoder.EncodeToken(xml.CopyToken(token)); err != nil {
+			return "", err
+		}
+	}
+
+	if err := encoder.Flush(); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
+
+// formatForm renders form fields one per line, joined by ampersands.
+func formatForm(fields map[string]interface{}) string {
+	pairs := make([]string, 0, len(fields))
+	for _, key := range sortedKeys(fields) {
+		pairs = append(pairs, url.QueryEscape(key)+"="+url.QueryEscape(fmt.Sprintf("%v", fields[key])))
+	}
+
+	return strings.Join(pairs, "&\n")
+}
+
+// highlightExample splits formatted example text into lines of highlighted tokens.
+func highlightExample(code, lang string) [][]exampleToken {
+	lines := strings.Split(code, "\n")
+	result := make([][]exampleToken, len(lines))
+
+	for i, line := range lines {
+		switch lang {
+		case exampleJSON:
+			result[i] = highlightJSONLine(line)
+		case exampleYAML:
+			result[i] = highlightYAMLLine(line)
+		case exampleXML:
+			result[i] = highlightXMLLine(line)
+		case exampleForm:
+			result[i] = highlightFormLine(line)
+		default:
+			result[i] = []exampleToken{{Kind: tokenPlain, Text: line}}
+		}
+	}
+
+	return result
+}
+
+func highlightJSONLine(line string) []exampleToken {
+	var tokens []exampleToken
+
+	for i := 0; i < len(line); {
+		ch := line[i]
+
+		switch {
+		case ch == '"':
+			end := i + 1
+			for end < len(line) && line[end] != '"' {
+				if line[end] == '\\' {
+					end++
+				}
+				end++
+			}
+			end = min(end+1, len(line))
+			kind := tokenString
+			if strings.HasPrefix(strings.TrimLeft(line[end:], " "), ":") {
+				kind = tokenKey
+			}
+			tokens = append(tokens, exampleToken{Kind: kind, Text: line[i:end]})
+			i = end
+
+		case ch == '-' || (ch >= '0' && ch <= '9'):
+			end := i + 1
+			for end < len(line) && strings.IndexByte("0123456789.eE+-", line[end]) >= 0 {
+				end++
+			}
+			tokens = append(tokens, exampleToken{Kind: tokenNumber, Text: line[i:end]})
+			i = end
+
+		case strings.IndexByte("{}[]:,", ch) >= 0:
+			tokens = append(tokens, exampleToken{Kind: tokenPunct, Text: string(ch)})
+			i++
+
+		case unicode.IsLetter(rune(ch)):
+			end := i
+			for end < len(line) && unicode.IsLetter(rune(line[end])) {
+				end++
+			}
+			tokens = append(tokens, exampleToken{Kind: tokenLiteral, Text: line[i:end]})
+			i = end
+
+		default:
+			tokens = append(tokens, exampleToken{Kind: tokenPlain, Text: string(ch)})
+			i++
+		}
+	}
+
+	return mergeTokens(tokens)
+}
+
+func highlightYAMLLine(line string) []exampleToken {
+	trimmed := strings.TrimLeft(line, " ")
+	indent := line[:len(line)-len(trimmed)]
+	tokens := []exampleToken{{Kind: tokenPlain, Text: indent}}
+
+	if strings.HasPrefix(trimmed, "#") {
+		return append(tokens, exampleToken{Kind: tokenComment, Text: trimmed})
+	}
+
+	for strings.HasPrefix(trimmed, "- ") {
+		tokens = append(tokens, exampleToken{Kind: tokenPunct, Text: "- "})
+		trimmed = trimmed[2:]
+	}
+
+	if sep := strings.Index(trimmed, ":"); sep > 0 && (sep == len(trimmed)-1 || trimmed[sep+1] == ' ') &&
+		!strings.ContainsAny(trimmed[:1], "\"'") {
+		tokens = append(tokens,
+			exampleToken{Kind: tokenKey, Text: trimmed[:sep]},
+			exampleToken{Kind: tokenPunct, Text: ":"})
+		trimmed = trimmed[sep+1:]
+		if strings.HasPrefix(trimmed, " ") {
+			tokens = append(tokens, exampleToken{Kind: tokenPlain, Text: " "})
+			trimmed = trimmed[1:]
+		}
+	}
+
+	if trimmed != "" {
+		tokens = append(tokens, exampleToken{Kind: yamlScalarKind(trimmed), Text: trimmed})
+	}
+
+	return mergeTokens(tokens)
+}
+
+func yamlScalarKind(value string) tokenKind {
+	switch strings.ToLower(value) {
+	case "true", "false", "null", "~":
+		return tokenLiteral
+	case "|", ">", "|-", ">-", "{}", "[]":
+		return tokenPunct
+	}
+
+	if _, err := fmt.Sscanf(value, "%g", new(float64)); err == nil && !strings.ContainsAny(value, " :") {
+		return tokenNumber
+	}
+
+	return tokenString
+}
+
+func highlightXMLLine(line string) []exampleToken {
+	var tokens []exampleToken
+
+	for i := 0; i < len(line); {
+		if strings.HasPrefix(line[i:], "<!--") {
+			end := strings.Index(line[i:], "-->")
+			if end < 0 {
+				end = len(line) - i - 3
+			}
+			tokens = append(tokens, exampleToken{Kind: tokenComment, Text: line[i : i+end+3]})
+			i += end + 3
+
+			continue
+		}
+
+		if line[i] != '<' {
+			end := strings.IndexByte(line[i:], '<')
+			if end < 0 {
+				end = len(line) - i
+			}
+			tokens = append(tokens, exampleToken{Kind: tokenPlain, Text: line[i : i+end]})
+			i += end
+
+			continue
+		}
+
+		end := strings.IndexByte(line[i:], '>')
+		if end < 0 {
+			end = len(line) - i - 1
+		}
+		tokens = append(tokens, highlightXMLTag(line[i:i+end+1])...)
+		i += end + 1
+	}
+
+	return mergeTokens(tokens)
+}
+
+// highlightXMLTag splits a single tag into its name, attribute names and values.
+func highlightXMLTag(tag string) []exampleToken {
+	nameEnd := strings.IndexAny(tag, " \t/>")
+	if nameEnd <= 1 {
+		nameEnd = strings.IndexAny(tag[1:], " \t>") + 1
+	}
+	if nameEnd <= 0 {
+		return []exampleToken{{Kind: tokenTag, Text: tag}}
+	}
+
+	tokens := []exampleToken{{Kind: tokenTag, Text: tag[:nameEnd]}}
+	rest := tag[nameEnd:]
+
+	for rest != "" {
+		switch {
+		case rest[0] == '"' || rest[0] == '\'':
+			end := strings.IndexByte(rest[1:], rest[0])
+			if end < 0 {
+				end = len(rest) - 2
+			}
+			tokens = append(tokens, exampleToken{Kind: tokenString, Text: rest[:end+2]})
+			rest = rest[end+2:]
+		case rest[0] == '/' || rest[0] == '>' || rest[0] == '?':
+			tokens = append(tokens, exampleToken{Kind: tokenTag, Text: rest[:1]})
+			rest = rest[1:]
+		case rest[0] == '=' || rest[0] == ' ' || rest[0] == '\t':
+			tokens = append(tokens, exampleToken{Kind: tokenPlain, Text: rest[:1]})
+			rest = rest[1:]
+		default:
+			end := strings.IndexAny(rest, "= \t/>")
+			if end < 0 {
+				end = len(rest)
+			}
+			if end == 0 {
+				end = 1
+			}
+			tokens = append(tokens, exampleToken{Kind: tokenAttr, Text: rest[:end]})
+			rest = rest[end:]
+		}
+	}
+
+	return tokens
+}
+
+func highlightFormLine(line string) []exampleToken {
+	key, value, found := strings.Cut(strings.TrimSuffix(line, "&"), "=")
+	tokens := []exampleToken{{Kind: tokenKey, Text: key}}
+
+	if found {
+		tokens = append(tokens,
+			exampleToken{Kind: tokenPunct, Text: "="},
+			exampleToken{Kind: tokenString, Text: value})
+	}
+	if strings.HasSuffix(line, "&") {
+		tokens = append(tokens, exampleToken{Kind: tokenPunct, Text: "&"})
+	}
+
+	return tokens
+}
+
+// mergeTokens joins adjacent tokens of the same kind and drops empty ones.
+func mergeTokens(tokens []exampleToken) []exampleToken {
+	merged := make([]exampleToken, 0, len(tokens))
+
+	for _, t := range tokens {
+		if t.Text == "" {
+			continue
+		}
+		if n := len(merged); n > 0 && merged[n-1].Kind == t.Kind {
+			merged[n-1].Text += t.Text
+			continue
+		}
+		merged = append(merged, t)
+	}
+
+	return merged
+}
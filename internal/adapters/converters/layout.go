@@ -0,0 +1,282 @@
+package converters
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/GabrielNunesIT/openapi-converter/internal/domain"
+)
+
+// documentLayout is the format-neutral structure of a rendered document. It
+// is built once per conversion, so every converter renders the same
+// sections, tags, endpoints and component schemas in the same order.
+type documentLayout struct {
+	sections []layoutSection
+	tags     []layoutTag // Tags of the API Endpoints section, sorted by name
+	warnings []domain.Warning
+}
+
+type layoutSectionKind int
+
+const (
+	sectionDescription layoutSectionKind = iota
+	sectionAuthentication
+	sectionServers
+	sectionEndpoints
+)
+
+// layoutSection is a top-level section of the document. Sections without
+// content are left out of the layout.
+type layoutSection struct {
+	kind  layoutSectionKind
+	title string
+	key   string // Anchor key
+}
+
+// layoutTag is a tag with its endpoints and the component schemas they use.
+type layoutTag struct {
+	name        string
+	description string
+	key         string
+	endpoints   []layoutEndpoint // Sorted by path, then method
+	components  []layoutComponent
+}
+
+type layoutEndpoint struct {
+	path      string
+	method    string
+	operation domain.Operation
+	key       string
+}
+
+// layoutComponent is a component schema rendered within a tag.
+type layoutComponent struct {
+	name   string
+	schema domain.Schema
+	key    string
+}
+
+// layoutLink is text linking to the part of the document with an anchor
+// key. Text without a key is not linked.
+type layoutLink struct {
+	text string
+	key  string
+}
+
+// The table opening each tag, which lists its endpoints.
+const endpointsSummaryTitle = "Endpoints in this section"
+
+var (
+	endpointsSummaryHeaders = []string{"Summary", "Path", "Method"}
+	endpointsSummaryWidths  = []float64{100, 75, 15} // Relative column widths
+)
+
+// endpointSummaryRow is a row of the endpoints table of a tag. Its summary
+// and path link to the endpoint.
+type endpointSummaryRow struct {
+	summary layoutLink
+	path    layoutLink
+	method  string
+}
+
+// summaryRows returns the rows of the endpoints table of the tag. Endpoints
+// without a summary get an empty, unlinked summary.
+func (t layoutTag) summaryRows() []endpointSummaryRow {
+	rows := make([]endpointSummaryRow, 0, len(t.endpoints))
+	for _, ep := range t.endpoints {
+		row := endpointSummaryRow{path: layoutLink{text: ep.path, key: ep.key}, method: ep.method}
+		if summary := markdownToText(ep.operation.Summary); summary != "" {
+			row.summary = layoutLink{text: summary, key: ep.key}
+		}
+
+		rows = append(rows, row)
+	}
+
+	return rows
+}
+
+// componentLink links a type name to the component schema rendered in the
+// tag; names without a referenced component are not linked.
+func (t layoutTag) componentLink(typeName, refName string) layoutLink {
+	if refName == "" {
+		return layoutLink{text: typeName}
+	}
+
+	return layoutLink{text: typeName, key: componentKey(t.name, refName)}
+}
+
+// newDocumentLayout lays out a document. Operations without tags are listed
+// under the default tag, and operations with several tags under each of them.
+func newDocumentLayout(doc *domain.OpenAPIDocument) *documentLayout {
+	layout := &documentLayout{}
+	layout.tags = layout.layoutTags(doc)
+
+	if doc.Description != "" {
+		layout.addSection(sectionDescription, "Description")
+	}
+	if len(doc.SecuritySchemes) > 0 {
+		layout.addSection(sectionAuthentication, "Authentication")
+	}
+	if len(doc.Servers) > 0 {
+		layout.addSection(sectionServers, "Servers")
+	}
+	if len(layout.tags) > 0 {
+		layout.addSection(sectionEndpoints, "API Endpoints")
+	}
+
+	return layout
+}
+
+func (l *documentLayout) addSection(kind layoutSectionKind, title string) {
+	l.sections = append(l.sections, layoutSection{kind: kind, title: title, key: "section:" + title})
+}
+
+func (l *documentLayout) layoutTags(doc *domain.OpenAPIDocument) []layoutTag {
+	endpoints := make(map[string][]layoutEndpoint)
+
+	for _, path := range doc.Paths {
+		for _, op := range path.Operations {
+			tags := op.Tags
+			if len(tags) == 0 {
+				tags = []string{domain.DefaultTag}
+			}
+
+			for _, tag := range tags {
+				endpoints[tag] = append(endpoints[tag], layoutEndpoint{
+					path:      path.Path,
+					method:    op.Method,
+					operation: op,
+					key:       endpointKey(tag, op.Method, path.Path),
+				})
+			}
+		}
+	}
+
+	descriptions := make(map[string]string)
+	for _, tag := range doc.Tags {
+		descriptions[tag.Name] = tag.Description
+	}
+
+	tags := make([]layoutTag, 0, len(endpoints))
+	for _, name := range sortedKeys(endpoints) {
+		eps := endpoints[name]
+		sort.SliceStable(eps, func(i, j int) bool {
+			if eps[i].path == eps[j].path {
+				return eps[i].method < eps[j].method
+			}
+
+			return eps[i].path < eps[j].path
+		})
+
+		tags = append(tags, layoutTag{
+			name:        name,
+			description: descriptions[name],
+			key:         tagKey(name),
+			endpoints:   eps,
+			components:  l.layoutComponents(name, eps, doc.Components),
+		})
+	}
+
+	return tags
+}
+
+// layoutComponents returns the component schemas reachable from the
+// parameters, request bodies and responses of endpoints, sorted by name.
+// References to components the document does not define are left out with
+// a warning.
+func (l *documentLayout) layoutComponents(tag string, endpoints []layoutEndpoint,
+	components map[string]domain.Schema,
+) []layoutComponent {
+	refs := make(map[string]struct{})
+
+	for _, ep := range endpoints {
+		epRefs := make(map[string]struct{})
+
+		for _, param := range ep.operation.Parameters {
+			collectSchemaRefs(param.Schema, components, epRefs)
+		}
+
+		if ep.operation.RequestBody != nil {
+			for _, media := range ep.operation.RequestBody.Content {
+				collectSchemaRefs(media.Schema, components, epRefs)
+			}
+		}
+
+		for _, resp := range ep.operation.Responses {
+			for _, media := range resp.Content {
+				collectSchemaRefs(media.Schema, components, epRefs)
+			}
+		}
+
+		for _, name := range sortedKeys(epRefs) {
+			refs[name] = struct{}{}
+
+			if _, ok := components[name]; !ok {
+				l.warn(fmt.Sprintf("%s %s", formatMethod(ep.method), ep.path), "Component %s is not defined and is not rendered", name)
+			}
+		}
+	}
+
+	result := make([]layoutComponent, 0, len(refs))
+	for _, name := range sortedKeys(refs) {
+		if schema, ok := components[name]; ok {
+			result = append(result, layoutComponent{name: name, schema: schema, key: componentKey(tag, name)})
+		}
+	}
+
+	return result
+}
+
+// warn records a warning once, as endpoints listed under several tags are
+// laid out more than once.
+func (l *documentLayout) warn(location, format string, args ...any) {
+	warning := domain.Warning{Location: location, Message: fmt.Sprintf(format, args...)}
+
+	for _, w := range l.warnings {
+		if w == warning {
+			return
+		}
+	}
+
+	l.warnings = append(l.warnings, warning)
+}
+
+// collectSchemaRefs collects the names of the components a schema refers
+// to, following references into the component definitions so components
+// used by other components are included.
+func collectSchemaRefs(schema domain.Schema, components map[string]domain.Schema, refs map[string]struct{}) {
+	if schema.Ref != "" {
+		name := extractRefName(schema.Ref)
+		if _, ok := refs[name]; ok {
+			return
+		}
+		refs[name] = struct{}{}
+
+		if component, ok := components[name]; ok {
+			collectSchemaRefs(component, components, refs)
+		}
+	}
+
+	for _, prop := range schema.Properties {
+		collectSchemaRefs(prop, components, refs)
+	}
+
+	if schema.Items != nil {
+		collectSchemaRefs(*schema.Items, components, refs)
+	}
+}
+
+// Anchor keys identify the parts of a document that can be linked to.
+// Converters map them to the anchor syntax of their format.
+
+func tagKey(tag string) string {
+	return "tag:" + tag
+}
+
+func endpointKey(tag, method, path string) string {
+	return fmt.Sprintf("endpoint:%s:%s %s", tag, method, path)
+}
+
+func componentKey(tag, name string) string {
+	return fmt.Sprintf("component:%s:%s", tag, name)
+}
@@ -0,0 +1,260 @@
+package converters
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"io"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/GabrielNunesIT/openapi-converter/internal/adapters/loaders"
+	"github.com/GabrielNunesIT/openapi-converter/internal/domain"
+)
+
+func TestNewDocumentLayout(t *testing.T) {
+	pet := domain.Schema{Ref: "#/components/schemas/Pet"}
+	doc := &domain.OpenAPIDocument{
+		Description:     "Pets",
+		Servers:         []domain.Server{{URL: "https://example.com"}},
+		Tags:            []domain.Tag{{Name: "store", Description: "Orders"}},
+		SecuritySchemes: map[string]domain.SecurityScheme{"key": {Type: "apiKey"}},
+		Paths: []domain.Path{
+			{Path: "/pets/{id}", Operations: []domain.Operation{{Method: "delete", Tags: []string{"pets"}}}},
+			{Path: "/pets", Operations: []domain.Operation{
+				{Method: "post", Tags: []string{"pets", "store"}, RequestBody: &domain.RequestBody{
+					Content: map[string]domain.MediaType{"application/json": {Schema: pet}},
+				}},
+				{Method: "get", Tags: []string{"pets"}, Summary: "List *pets*"},
+			}},
+			{Path: "/health", Operations: []domain.Operation{{Method: "get", Responses: []domain.Response{{
+				StatusCode: "200",
+				Content: map[string]domain.MediaType{
+					"application/json": {Schema: domain.Schema{Ref: "#/components/schemas/Health"}},
+				},
+			}}}}},
+		},
+		Components: map[string]domain.Schema{
+			"Pet":   {Type: "object", Properties: map[string]domain.Schema{"owner": {Ref: "#/components/schemas/Owner"}}},
+			"Owner": {Type: "object"},
+		},
+	}
+
+	layout := newDocumentLayout(doc)
+
+	var sections []string
+	for _, section := range layout.sections {
+		sections = append(sections, section.key)
+	}
+	wantSections := []string{"section:Description", "section:Authentication", "section:Servers", "section:API Endpoints"}
+	if !slices.Equal(sections, wantSections) {
+		t.Errorf("sections = %v, want %v", sections, wantSections)
+	}
+
+	type tagLayout struct {
+		key         string
+		description string
+		endpoints   []string
+		components  []string
+	}
+
+	want := []tagLayout{
+		{key: "tag:Default", endpoints: []string{"endpoint:Default:get /health"}},
+		{
+			key:        "tag:pets",
+			endpoints:  []string{"endpoint:pets:get /pets", "endpoint:pets:post /pets", "endpoint:pets:delete /pets/{id}"},
+			components: []string{"component:pets:Owner", "component:pets:Pet"},
+		},
+		{
+			key:         "tag:store",
+			description: "Orders",
+			endpoints:   []string{"endpoint:store:post /pets"},
+			components:  []string{"component:store:Owner", "component:store:Pet"},
+		},
+	}
+
+	var got []tagLayout
+	for _, tag := range layout.tags {
+		tl := tagLayout{key: tag.key, description: tag.description}
+		for _, ep := range tag.endpoints {
+			tl.endpoints = append(tl.endpoints, ep.key)
+		}
+		for _, component := range tag.components {
+			tl.components = append(tl.components, component.key)
+		}
+		got = append(got, tl)
+	}
+
+	if len(got) != len(want) {
+		t.Fatalf("tags = %+v, want %+v", got, want)
+	}
+	for i := range want {
+		if got[i].key != want[i].key || got[i].description != want[i].description ||
+			!slices.Equal(got[i].endpoints, want[i].endpoints) || !slices.Equal(got[i].components, want[i].components) {
+			t.Errorf("tag %d = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+
+	wantWarnings := []domain.Warning{
+		{Location: "GET /health", Message: "Component Health is not defined and is not rendered"},
+	}
+	if !slices.Equal(layout.warnings, wantWarnings) {
+		t.Errorf("warnings = %v, want %v", layout.warnings, wantWarnings)
+	}
+
+	rows := layout.tags[1].summaryRows()
+	wantRow := endpointSummaryRow{
+		summary: layoutLink{text: "List pets", key: "endpoint:pets:get /pets"},
+		path:    layoutLink{text: "/pets", key: "endpoint:pets:get /pets"},
+		method:  "get",
+	}
+	if len(rows) != 3 || rows[0] != wantRow || rows[1].summary != (layoutLink{}) {
+		t.Errorf("summary rows = %+v, want %+v first and no summary link without a summary", rows, wantRow)
+	}
+
+	if got := layout.tags[1].componentLink("Pet", "Pet"); got.key != "component:pets:Pet" {
+		t.Errorf("componentLink() = %+v, want a link to the component in the tag", got)
+	}
+	if got := layout.tags[1].componentLink("string", ""); got.key != "" {
+		t.Errorf("componentLink() = %+v, want no link for a type without a component", got)
+	}
+}
+
+// anchoredOutput is how a converter marks the targets of internal links and
+// links to them.
+type anchoredOutput struct {
+	converter func() (domain.ContextConverter, func() map[string]string)
+	part      string // File of a zip package holding the content, empty for plain text
+	target    *regexp.Regexp
+	link      *regexp.Regexp
+}
+
+// TestConvertersFollowLayout checks that the converters sharing the layout
+// render its tags, components and endpoints in the same order, and that
+// every internal link has a target.
+func TestConvertersFollowLayout(t *testing.T) {
+	doc, _, err := loaders.NewOpenAPILoader().Load(context.Background(), "testdata/petstore.yaml")
+	if err != nil {
+		t.Fatalf("failed to load spec: %v", err)
+	}
+
+	var want []string
+	for _, tag := range newDocumentLayout(doc).tags {
+		want = append(want, tag.key)
+		for _, component := range tag.components {
+			want = append(want, component.key)
+		}
+		for _, ep := range tag.endpoints {
+			want = append(want, ep.key)
+		}
+	}
+
+	outputs := map[string]anchoredOutput{
+		"asciidoc": {
+			converter: func() (domain.ContextConverter, func() map[string]string) {
+				c := NewAsciiDocConverter()
+				return c, func() map[string]string { return c.anchors }
+			},
+			target: regexp.MustCompile(`(?m)^\[#([^\]]+)\]$`),
+			link:   regexp.MustCompile(`<<([^,>]+),`),
+		},
+		"confluence-storage": {
+			converter: func() (domain.ContextConverter, func() map[string]string) {
+				c := NewConfluenceStorageConverter()
+				return c, func() map[string]string { return c.anchors }
+			},
+			target: regexp.MustCompile(`ac:name="anchor" ac:schema-version="1"><ac:parameter ac:name="">([^<]+)<`),
+			link:   regexp.MustCompile(`<ac:link ac:anchor="([^"]+)"`),
+		},
+		"docx": {
+			converter: func() (domain.ContextConverter, func() map[string]string) {
+				c := NewDocxConverter()
+				return c, func() map[string]string { return c.anchors }
+			},
+			part:   "word/document.xml",
+			target: regexp.MustCompile(`<w:bookmarkStart w:id="\d+" w:name="([^"]+)"`),
+			link:   regexp.MustCompile(`<w:hyperlink w:anchor="([^"]+)"`),
+		},
+		"odt": {
+			converter: func() (domain.ContextConverter, func() map[string]string) {
+				c := NewODTConverter()
+				return c, func() map[string]string { return c.anchors }
+			},
+			part:   "content.xml",
+			target: regexp.MustCompile(`<text:bookmark text:name="([^"]+)"/>`),
+			link:   regexp.MustCompile(`xlink:href="#([^"]+)"`),
+		},
+	}
+
+	for name, output := range outputs {
+		t.Run(name, func(t *testing.T) {
+			converter, anchors := output.converter()
+
+			var buf bytes.Buffer
+			if _, err := converter.ConvertContext(context.Background(), doc, &buf, domain.ConvertOptions{}); err != nil {
+				t.Fatalf("ConvertContext() error = %v", err)
+			}
+
+			content := buf.String()
+			if output.part != "" {
+				content = readZipPart(t, buf.Bytes(), output.part)
+			}
+
+			keys := make(map[string]string)
+			for key, id := range anchors() {
+				keys[id] = key
+			}
+
+			defined := make(map[string]bool)
+			var got []string
+			for _, match := range output.target.FindAllStringSubmatch(content, -1) {
+				defined[match[1]] = true
+
+				// Sections and the headings some formats add for their
+				// table of contents are not part of the tag layout
+				key, _, _ := strings.Cut(keys[match[1]], ":")
+				if key == "tag" || key == "component" || key == "endpoint" {
+					got = append(got, keys[match[1]])
+				}
+			}
+
+			if !slices.Equal(got, want) {
+				t.Errorf("anchors in order = %v\nwant %v", got, want)
+			}
+
+			links := output.link.FindAllStringSubmatch(content, -1)
+			if len(links) == 0 {
+				t.Error("no internal links")
+			}
+			for _, match := range links {
+				if !defined[match[1]] {
+					t.Errorf("link to %s (%s) has no target", match[1], keys[match[1]])
+				}
+			}
+		})
+	}
+}
+
+func readZipPart(t *testing.T, data []byte, name string) string {
+	t.Helper()
+
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatalf("output is not a zip package: %v", err)
+	}
+
+	f, err := zr.Open(name)
+	if err != nil {
+		t.Fatalf("package has no %s: %v", name, err)
+	}
+	defer f.Close()
+
+	content, err := io.ReadAll(f)
+	if err != nil {
+		t.Fatalf("failed to read %s: %v", name, err)
+	}
+
+	return string(content)
+}
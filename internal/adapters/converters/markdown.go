@@ -0,0 +1,535 @@
+package converters
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// mdBlockType identifies the kind of a block in the rich-text tree.
+type mdBlockType int
+
+const (
+	mdParagraph mdBlockType = iota
+	mdHeading
+	mdBulletList
+	mdOrderedList
+	mdCodeBlock
+	mdQuote
+	mdRule
+)
+
+// mdBlock is a block-level node of a parsed CommonMark description.
+type mdBlock struct {
+	Type    mdBlockType
+	Level   int         // Heading level (1-6)
+	Start   int         // First number of an ordered list
+	Lang    string      // Info string of a fenced code block
+	Code    string      // Contents of a code block
+	Inlines []mdInline  // Text of paragraphs and headings
+	Items   [][]mdBlock // Items of bullet and ordered lists
+	Blocks  []mdBlock   // Children of block quotes
+}
+
+// mdInline is a run of text sharing the same styling.
+type mdInline struct {
+	Text   string
+	Bold   bool
+	Italic bool
+	Code   bool
+	Link   string // Link destination, empty for plain text
+}
+
+var (
+	mdFenceRe     = regexp.MustCompile("^ {0,3}(`{3,}|~{3,})\\s*([^`\\s]*)")
+	mdHeadingRe   = regexp.MustCompile(`^ {0,3}(#{1,6})(?:\s+(.*?))?\s*#*\s*$`)
+	mdRuleRe      = regexp.MustCompile(`^ {0,3}([-*_])(?:\s*([-*_])){2,}\s*$`)
+	mdBulletRe    = regexp.MustCompile(`^( {0,3})([-*+])(\s+|$)`)
+	mdOrderedRe   = regexp.MustCompile(`^( {0,3})(\d{1,9})[.)](\s+|$)`)
+	mdQuoteRe     = regexp.MustCompile(`^ {0,3}> ?`)
+	mdHTMLTagRe   = regexp.MustCompile(`^</?[A-Za-z][A-Za-z0-9-]*(?:\s[^<>]*)?/?>`)
+	mdAutolinkRe  = regexp.MustCompile(`^<([A-Za-z][A-Za-z0-9+.-]{1,31}:[^<>\s]*)>`)
+	mdLineBreakRe = regexp.MustCompile(`(?i)<br\s*/?>`)
+)
+
+// parseMarkdown parses a CommonMark description into a block tree.
+// It supports the subset used in API descriptions: paragraphs, ATX headings,
+// bullet and ordered lists, fenced and indented code, block quotes and
+// thematic breaks, with emphasis, code spans, links and inline HTML.
+func parseMarkdown(src string) []mdBlock {
+	src = strings.ReplaceAll(src, "\r\n", "\n")
+	src = strings.ReplaceAll(src, "\t", "    ")
+	src = mdLineBreakRe.ReplaceAllString(src, "\\\n")
+
+	return parseMarkdownLines(strings.Split(src, "\n"))
+}
+
+func parseMarkdownLines(lines []string) []mdBlock {
+	var blocks []mdBlock
+	var para []string
+
+	flush := func() {
+		if len(para) > 0 {
+			blocks = append(blocks, mdBlock{Type: mdParagraph, Inlines: parseInlines(joinParagraph(para))})
+			para = nil
+		}
+	}
+
+	for i := 0; i < len(lines); i++ {
+		line := lines[i]
+
+		switch {
+		case strings.TrimSpace(line) == "":
+			flush()
+
+		case mdFenceRe.MatchString(line):
+			flush()
+			m := mdFenceRe.FindStringSubmatch(line)
+			fence := m[1]
+			var code []string
+			for i++; i < len(lines); i++ {
+				if strings.HasPrefix(strings.TrimSpace(lines[i]), fence) {
+					break
+				}
+				code = append(code, lines[i])
+			}
+			blocks = append(blocks, mdBlock{Type: mdCodeBlock, Lang: m[2], Code: strings.Join(code, "\n")})
+
+		case len(para) == 0 && strings.HasPrefix(line, "    "):
+			var code []string
+			for ; i < len(lines); i++ {
+				if strings.TrimSpace(lines[i]) != "" && !strings.HasPrefix(lines[i], "    ") {
+					break
+				}
+				code = append(code, strings.TrimPrefix(lines[i], "    "))
+			}
+			i--
+			blocks = append(blocks, mdBlock{Type: mdCodeBlock, Code: strings.TrimRight(strings.Join(code, "\n"), "\n")})
+
+		case mdHeadingRe.MatchString(line):
+			flush()
+			m := mdHeadingRe.FindStringSubmatch(line)
+			blocks = append(blocks, mdBlock{Type: mdHeading, Level: len(m[1]), Inlines: parseInlines(m[2])})
+
+		case mdRuleRe.MatchString(line):
+			flush()
+			blocks = append(blocks, mdBlock{Type: mdRule})
+
+		case mdQuoteRe.MatchString(line):
+			flush()
+			var quoted []string
+			for ; i < len(lines) && mdQuoteRe.MatchString(lines[i]); i++ {
+				quoted = append(quoted, mdQuoteRe.ReplaceAllString(lines[i], ""))
+			}
+			i--
+			blocks = append(blocks, mdBlock{Type: mdQuote, Blocks: parseMarkdownLines(quoted)})
+
+		case mdBulletRe.MatchString(line) || mdOrderedRe.MatchString(line):
+			flush()
+			var list mdBlock
+			list, i = parseList(lines, i)
+			blocks = append(blocks, list)
+			i--
+
+		default:
+			para = append(para, line)
+		}
+	}
+
+	flush()
+
+	return blocks
+}
+
+// parseList consumes a list starting at lines[start] and returns it with the
+// index of the first line after the list.
+func parseList(lines []string, start int) (mdBlock, int) {
+	list := mdBlock{Type: mdBulletList}
+	ordered := mdOrderedRe.MatchString(lines[start])
+	if ordered {
+		list.Type = mdOrderedList
+		list.Start, _ = strconv.Atoi(mdOrderedRe.FindStringSubmatch(lines[start])[2])
+	}
+
+	markerRe := mdBulletRe
+	if ordered {
+		markerRe = mdOrderedRe
+	}
+
+	i := start
+	for i < len(lines) {
+		m := markerRe.FindStringSubmatchIndex(lines[i])
+		if m == nil {
+			break
+		}
+
+		contentIndent := m[1]
+		if m[7] == m[6] || m[7]-m[6] > 4 {
+			contentIndent = m[5] + 1
+		}
+
+		item := []string{lines[i][min(contentIndent, len(lines[i])):]}
+		for i++; i < len(lines); i++ {
+			line := lines[i]
+			indent := len(line) - len(strings.TrimLeft(line, " "))
+
+			if strings.TrimSpace(line) == "" {
+				// A blank line continues the item only if indented content follows
+				if i+1 < len(lines) && leadingSpaces(lines[i+1]) >= contentIndent {
+					item = append(item, "")
+					continue
+				}
+				break
+			}
+
+			if indent >= contentIndent {
+				item = append(item, line[contentIndent:])
+				continue
+			}
+
+			// Lazy continuation of the item's paragraph
+			if markerRe.MatchString(line) || isBlockStart(line) || item[len(item)-1] == "" {
+				break
+			}
+			item = append(item, strings.TrimLeft(line, " "))
+		}
+
+		list.Items = append(list.Items, parseMarkdownLines(item))
+
+		// Skip a single blank line between items of a loose list
+		if i+1 < len(lines) && strings.TrimSpace(lines[i]) == "" && markerRe.MatchString(lines[i+1]) {
+			i++
+		}
+	}
+
+	return list, i
+}
+
+func leadingSpaces(s string) int {
+	if strings.TrimSpace(s) == "" {
+		return 0
+	}
+
+	return len(s) - len(strings.TrimLeft(s, " "))
+}
+
+func isBlockStart(line string) bool {
+	return mdFenceRe.MatchString(line) || mdHeadingRe.MatchString(line) || mdRuleRe.MatchString(line) ||
+		mdQuoteRe.MatchString(line) || mdBulletRe.MatchString(line) || mdOrderedRe.MatchString(line)
+}
+
+// joinParagraph joins paragraph lines, turning hard breaks into newlines and
+// soft breaks into spaces.
+func joinParagraph(lines []string) string {
+	var b strings.Builder
+
+	for i, line := range lines {
+		line = strings.TrimLeft(line, " ")
+		hard := strings.HasSuffix(line, "  ") || strings.HasSuffix(line, "\\")
+		line = strings.TrimRight(strings.TrimSuffix(strings.TrimRight(line, " "), "\\"), " ")
+		b.WriteString(line)
+
+		if i < len(lines)-1 {
+			if hard {
+				b.WriteString("\n")
+			} else {
+				b.WriteString(" ")
+			}
+		}
+	}
+
+	return b.String()
+}
+
+// parseInlines parses inline CommonMark markup into styled runs.
+func parseInlines(s string) []mdInline {
+	var runs []mdInline
+	parseInlinesInto(s, mdInline{}, &runs)
+
+	return mergeInlines(runs)
+}
+
+//nolint:gocognit,cyclop,funlen // A single-pass scanner reads clearer than a split state machine
+func parseInlinesInto(s string, style mdInline, runs *[]mdInline) {
+	var text strings.Builder
+
+	emit := func(t string, st mdInline) {
+		if t == "" {
+			return
+		}
+		st.Text = t
+		*runs = append(*runs, st)
+	}
+	flush := func() {
+		emit(html.UnescapeString(text.String()), style)
+		text.Reset()
+	}
+
+	for i := 0; i < len(s); i++ {
+		ch := s[i]
+
+		switch {
+		case ch == '\\' && i+1 < len(s) && strings.IndexByte("!\"#$%&'()*+,-./:;<=>?@[\\]^_`{|}~", s[i+1]) >= 0:
+			text.WriteByte(s[i+1])
+			i++
+
+		case ch == '`':
+			n := countRun(s[i:], '`')
+			closing := strings.Index(s[i+n:], strings.Repeat("`", n))
+			if closing < 0 {
+				text.WriteString(s[i : i+n])
+				i += n - 1
+				continue
+			}
+			flush()
+			code := strings.ReplaceAll(s[i+n:i+n+closing], "\n", " ")
+			if len(code) > 2 && code[0] == ' ' && code[len(code)-1] == ' ' {
+				code = code[1 : len(code)-1]
+			}
+			st := style
+			st.Code = true
+			emit(code, st)
+			i += n + closing + n - 1
+
+		case ch == '*' || ch == '_':
+			n := min(countRun(s[i:], ch), 2)
+			if !canOpenEmphasis(s, i, n, ch) {
+				text.WriteString(s[i : i+n])
+				i += n - 1
+				continue
+			}
+			end := findClosingEmphasis(s, i+n, n, ch)
+			if end < 0 {
+				text.WriteString(s[i : i+n])
+				i += n - 1
+				continue
+			}
+			flush()
+			st := style
+			if n == 2 {
+				st.Bold = true
+			} else {
+				st.Italic = true
+			}
+			parseInlinesInto(s[i+n:end], st, runs)
+			i = end + n - 1
+
+		case ch == '[' || (ch == '!' && i+1 < len(s) && s[i+1] == '['):
+			start := i
+			if ch == '!' {
+				start++
+			}
+			label, dest, next, ok := parseLink(s, start)
+			if !ok {
+				text.WriteByte(ch)
+				continue
+			}
+			flush()
+			st := style
+			if ch != '!' {
+				st.Link = dest
+			}
+			parseInlinesInto(label, st, runs)
+			i = next - 1
+
+		case ch == '<':
+			if m := mdAutolinkRe.FindStringSubmatch(s[i:]); m != nil {
+				flush()
+				st := style
+				st.Link = m[1]
+				emit(m[1], st)
+				i += len(m[0]) - 1
+				continue
+			}
+			if m := mdHTMLTagRe.FindString(s[i:]); m != "" {
+				i += len(m) - 1
+				continue
+			}
+			text.WriteByte(ch)
+
+		default:
+			text.WriteByte(ch)
+		}
+	}
+
+	flush()
+}
+
+func countRun(s string, ch byte) int {
+	n := 0
+	for n < len(s) && s[n] == ch {
+		n++
+	}
+
+	return n
+}
+
+// canOpenEmphasis reports whether the delimiter run at s[i:i+n] opens emphasis.
+// Underscores inside words (snake_case identifiers) never open emphasis.
+func canOpenEmphasis(s string, i, n int, ch byte) bool {
+	if i+n >= len(s) || s[i+n] == ' ' || s[i+n] == '\n' {
+		return false
+	}
+
+	return ch != '_' || i == 0 || !isWordByte(s[i-1])
+}
+
+// findClosingEmphasis returns the index of the delimiter run closing emphasis
+// opened before from, or -1 when there is none.
+func findClosingEmphasis(s string, from, n int, ch byte) int {
+	for j := from; j+n <= len(s); j++ {
+		switch s[j] {
+		case '`':
+			// Delimiters inside code spans do not count
+			run := countRun(s[j:], '`')
+			if end := strings.Index(s[j+run:], strings.Repeat("`", run)); end >= 0 {
+				j += run + end + run - 1
+			}
+			continue
+		case '\\':
+			j++
+			continue
+		case ch:
+		default:
+			continue
+		}
+
+		run := countRun(s[j:], ch)
+		if j == from || s[j-1] == ' ' {
+			j += run - 1
+			continue
+		}
+		if ch == '_' && j+run < len(s) && isWordByte(s[j+run]) {
+			j += run - 1
+			continue
+		}
+		switch {
+		case run == n:
+			return j
+		case run == 3:
+			// "***" closes nested emphasis first, then ours
+			return j + run - n
+		default:
+			// Nested emphasis of the other kind
+			j += run - 1
+		}
+	}
+
+	return -1
+}
+
+// parseLink parses "[label](destination)" starting at s[start] == '['.
+func parseLink(s string, start int) (string, string, int, bool) {
+	depth := 0
+	closeLabel := -1
+
+	for j := start; j < len(s); j++ {
+		if s[j] == '\\' {
+			j++
+			continue
+		}
+		if s[j] == '[' {
+			depth++
+		} else if s[j] == ']' {
+			depth--
+			if depth == 0 {
+				closeLabel = j
+				break
+			}
+		}
+	}
+
+	if closeLabel < 0 || closeLabel+1 >= len(s) || s[closeLabel+1] != '(' {
+		return "", "", 0, false
+	}
+
+	closeDest := strings.IndexByte(s[closeLabel+2:], ')')
+	if closeDest < 0 {
+		return "", "", 0, false
+	}
+
+	dest := strings.TrimSpace(s[closeLabel+2 : closeLabel+2+closeDest])
+	// Drop an optional link title
+	if sp := strings.IndexAny(dest, " \n"); sp >= 0 {
+		dest = dest[:sp]
+	}
+	dest = strings.TrimSuffix(strings.TrimPrefix(dest, "<"), ">")
+
+	return s[start+1 : closeLabel], dest, closeLabel + 2 + closeDest + 1, true
+}
+
+func isWordByte(b byte) bool {
+	return b == '_' || (b >= '0' && b <= '9') || (b >= 'a' && b <= 'z') || (b >= 'A' && b <= 'Z') || b >= 0x80
+}
+
+// mergeInlines joins adjacent runs that share the same styling.
+func mergeInlines(runs []mdInline) []mdInline {
+	merged := make([]mdInline, 0, len(runs))
+
+	for _, run := range runs {
+		if n := len(merged); n > 0 {
+			last := &merged[n-1]
+			if last.Bold == run.Bold && last.Italic == run.Italic && last.Code == run.Code && last.Link == run.Link {
+				last.Text += run.Text
+				continue
+			}
+		}
+		merged = append(merged, run)
+	}
+
+	return merged
+}
+
+// markdownToText renders a CommonMark description as plain text, for places
+// such as table cells that cannot hold rich content.
+func markdownToText(src string) string {
+	var b strings.Builder
+	writeBlocksText(&b, parseMarkdown(src), "")
+
+	return strings.TrimSpace(b.String())
+}
+
+func writeBlocksText(b *strings.Builder, blocks []mdBlock, indent string) {
+	for _, block := range blocks {
+		switch block.Type {
+		case mdParagraph, mdHeading:
+			b.WriteString(indent + inlinesText(block.Inlines) + "\n")
+		case mdCodeBlock:
+			for _, line := range strings.Split(block.Code, "\n") {
+				b.WriteString(indent + line + "\n")
+			}
+		case mdQuote:
+			writeBlocksText(b, block.Blocks, indent)
+		case mdBulletList, mdOrderedList:
+			for n, item := range block.Items {
+				marker := "- "
+				if block.Type == mdOrderedList {
+					marker = fmt.Sprintf("%d. ", block.Start+n)
+				}
+				var itemText strings.Builder
+				writeBlocksText(&itemText, item, "")
+				lines := strings.Split(strings.TrimRight(itemText.String(), "\n"), "\n")
+				for k, line := range lines {
+					if k == 0 {
+						b.WriteString(indent + marker + line + "\n")
+					} else {
+						b.WriteString(indent + strings.Repeat(" ", len(marker)) + line + "\n")
+					}
+				}
+			}
+		case mdRule:
+		}
+	}
+}
+
+// inlinesText concatenates the text of inline runs.
+func inlinesText(inlines []mdInline) string {
+	var b strings.Builder
+	for _, in := range inlines {
+		b.WriteString(in.Text)
+	}
+
+	return b.String()
+}
@@ -0,0 +1,198 @@
+package converters
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseInlines(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want []mdInline
+	}{
+		{
+			name: "emphasis",
+			src:  "a *b* __c__ _d_",
+			want: []mdInline{
+				{Text: "a "}, {Text: "b", Italic: true}, {Text: " "}, {Text: "c", Bold: true}, {Text: " "},
+				{Text: "d", Italic: true},
+			},
+		},
+		{
+			name: "nested emphasis",
+			src:  "**bold *both***",
+			want: []mdInline{{Text: "bold ", Bold: true}, {Text: "both", Bold: true, Italic: true}},
+		},
+		{
+			name: "underscores inside words",
+			src:  "use snake_case_names",
+			want: []mdInline{{Text: "use snake_case_names"}},
+		},
+		{
+			name: "code span",
+			src:  "call `get()` or `` a`b ``",
+			want: []mdInline{{Text: "call "}, {Text: "get()", Code: true}, {Text: " or "}, {Text: "a`b", Code: true}},
+		},
+		{
+			name: "markup inside code span",
+			src:  "`*x* [y](z)`",
+			want: []mdInline{{Text: "*x* [y](z)", Code: true}},
+		},
+		{
+			name: "link",
+			src:  "see [the *docs*](https://example.com/docs)",
+			want: []mdInline{
+				{Text: "see "}, {Text: "the ", Link: "https://example.com/docs"},
+				{Text: "docs", Italic: true, Link: "https://example.com/docs"},
+			},
+		},
+		{
+			name: "autolink",
+			src:  "<https://example.com>",
+			want: []mdInline{{Text: "https://example.com", Link: "https://example.com"}},
+		},
+		{
+			name: "image",
+			src:  "![diagram](diagram.png)",
+			want: []mdInline{{Text: "diagram"}},
+		},
+		{
+			name: "inline HTML and entities",
+			src:  "<b>a</b> &amp; b",
+			want: []mdInline{{Text: "a & b"}},
+		},
+		{
+			name: "escapes",
+			src:  `\*not emphasis\*`,
+			want: []mdInline{{Text: "*not emphasis*"}},
+		},
+		{
+			name: "unclosed emphasis",
+			src:  "**open and *half",
+			want: []mdInline{{Text: "**open and *half"}},
+		},
+		{
+			name: "unclosed code span",
+			src:  "``open `code",
+			want: []mdInline{{Text: "``open `code"}},
+		},
+		{
+			name: "unclosed link",
+			src:  "[label](no end",
+			want: []mdInline{{Text: "[label](no end"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseInlines(tt.src); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseInlines(%q) = %+v, want %+v", tt.src, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseMarkdown(t *testing.T) {
+	text := func(s string) []mdInline {
+		return []mdInline{{Text: s}}
+	}
+	paragraph := func(s string) mdBlock {
+		return mdBlock{Type: mdParagraph, Inlines: text(s)}
+	}
+
+	tests := []struct {
+		name string
+		src  string
+		want []mdBlock
+	}{
+		{
+			name: "paragraphs and breaks",
+			src:  "one\ntwo  \nthree<br>four\n\nfive",
+			want: []mdBlock{paragraph("one two\nthree\nfour"), paragraph("five")},
+		},
+		{
+			name: "headings and rule",
+			src:  "# Title #\n### Section\n\n---",
+			want: []mdBlock{
+				{Type: mdHeading, Level: 1, Inlines: text("Title")},
+				{Type: mdHeading, Level: 3, Inlines: text("Section")},
+				{Type: mdRule},
+			},
+		},
+		{
+			name: "bullet list",
+			src:  "- a\n- b\n  continued\n\n  second paragraph\n- c",
+			want: []mdBlock{{Type: mdBulletList, Items: [][]mdBlock{
+				{paragraph("a")},
+				{paragraph("b continued"), paragraph("second paragraph")},
+				{paragraph("c")},
+			}}},
+		},
+		{
+			name: "ordered list with nested list",
+			src:  "3. first\n4. second\n   * nested\n\nafter",
+			want: []mdBlock{
+				{Type: mdOrderedList, Start: 3, Items: [][]mdBlock{
+					{paragraph("first")},
+					{paragraph("second"), {Type: mdBulletList, Items: [][]mdBlock{{paragraph("nested")}}}},
+				}},
+				paragraph("after"),
+			},
+		},
+		{
+			name: "fenced code",
+			src:  "```json\n{\"a\": 1}\n\n# not a heading\n```\ntext",
+			want: []mdBlock{
+				{Type: mdCodeBlock, Lang: "json", Code: "{\"a\": 1}\n\n# not a heading"},
+				paragraph("text"),
+			},
+		},
+		{
+			name: "unclosed fence",
+			src:  "~~~\ncode\n- not a list",
+			want: []mdBlock{{Type: mdCodeBlock, Code: "code\n- not a list"}},
+		},
+		{
+			name: "indented code",
+			src:  "    line 1\n\n    line 2\ntext",
+			want: []mdBlock{{Type: mdCodeBlock, Code: "line 1\n\nline 2"}, paragraph("text")},
+		},
+		{
+			name: "block quote",
+			src:  "> quoted\n> # heading",
+			want: []mdBlock{{Type: mdQuote, Blocks: []mdBlock{
+				paragraph("quoted"),
+				{Type: mdHeading, Level: 1, Inlines: text("heading")},
+			}}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseMarkdown(tt.src); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseMarkdown(%q) =\n%+v\nwant\n%+v", tt.src, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMarkdownToText(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want string
+	}{
+		{name: "inline markup", src: "The **pet** `id`, see [docs](https://example.com)", want: "The pet id, see docs"},
+		{name: "list", src: "Status:\n- available\n- sold", want: "Status:\n- available\n- sold"},
+		{name: "unclosed markers", src: "a *b `c [d", want: "a *b `c [d"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := markdownToText(tt.src); got != tt.want {
+				t.Errorf("markdownToText(%q) = %q, want %q", tt.src, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,750 @@
+package converters
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/GabrielNunesIT/openapi-converter/internal/domain"
+)
+
+const (
+	odtFormat   = "odt"
+	odtMimeType = "application/vnd.oasis.opendocument.text"
+
+	// odtTextWidth is the text width in cm of an A4 page with 2 cm margins.
+	odtTextWidth = 17.0
+
+	odtTOCLevels = 3
+)
+
+// ODTConverter converts OpenAPI documents to OpenDocument Text (ODT) format,
+// mirroring the structure of the DOCX output.
+type ODTConverter struct {
+	generateExamples bool
+
+	// Per-conversion state
+	conv       *conversion
+	synth      *exampleSynthesizer
+	body       strings.Builder
+	autoStyles strings.Builder
+	headings   []odtHeading
+	anchors    map[string]string // Map "kind:tag:name" keys to bookmark names
+	tables     int
+	currentTag layoutTag
+}
+
+// ODTOption configures an ODTConverter.
+type ODTOption func(*ODTConverter)
+
+// WithODTGeneratedExamples enables synthesizing examples from schemas for
+// request and response bodies that have none.
+func WithODTGeneratedExamples(enabled bool) ODTOption {
+	return func(c *ODTConverter) {
+		c.generateExamples = enabled
+	}
+}
+
+// NewODTConverter creates a new ODT converter.
+func NewODTConverter(opts ...ODTOption) *ODTConverter {
+	c := &ODTConverter{}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+// Format returns the output format name.
+func (c *ODTConverter) Format() string {
+	return odtFormat
+}
+
+// odtHeading is a heading collected for the table of contents.
+type odtHeading struct {
+	text   string
+	level  int
+	anchor string
+}
+
+// Convert transforms an OpenAPI document to ODT format.
+func (c *ODTConverter) Convert(doc *domain.OpenAPIDocument, output io.Writer) error {
+	_, err := c.ConvertContext(context.Background(), doc, output, domain.ConvertOptions{})
+
+	return err
+}
+
+// ConvertContext implements domain.ContextConverter.
+func (c *ODTConverter) ConvertContext(ctx context.Context, doc *domain.OpenAPIDocument, output io.Writer,
+	opts domain.ConvertOptions,
+) ([]domain.Warning, error) {
+	c.body.Reset()
+	c.autoStyles.Reset()
+	c.headings = nil
+	c.anchors = make(map[string]string)
+	c.tables = 0
+	c.currentTag = layoutTag{}
+	c.synth = nil
+	if c.generateExamples {
+		c.synth = newExampleSynthesizer(doc.Components)
+	}
+
+	layout := newDocumentLayout(doc)
+	c.conv = newConversion(ctx, layout, opts)
+
+	for _, section := range layout.sections {
+		if !c.conv.startSection(section) {
+			break
+		}
+
+		switch section.kind {
+		case sectionDescription:
+			c.addDescription(section, doc)
+		case sectionAuthentication:
+			c.addSecurity(section, doc)
+		case sectionServers:
+			c.addServers(section, doc)
+		case sectionEndpoints:
+			c.addPaths(section, layout)
+		}
+	}
+
+	if err := c.conv.finish(); err != nil {
+		return c.conv.warnings, err
+	}
+
+	content := c.contentXML(doc)
+
+	if err := c.writePackage(output, doc, content); err != nil {
+		return c.conv.warnings, fmt.Errorf("failed to write document: %w", err)
+	}
+
+	return c.conv.warnings, nil
+}
+
+// writePackage writes the ODF zip package. The mimetype entry must come first
+// and be stored uncompressed.
+func (c *ODTConverter) writePackage(output io.Writer, doc *domain.OpenAPIDocument, content string) error {
+	zw := zip.NewWriter(output)
+
+	w, err := zw.CreateHeader(&zip.FileHeader{Name: "mimetype", Method: zip.Store})
+	if err != nil {
+		return err
+	}
+	if _, err := io.WriteString(w, odtMimeType); err != nil {
+		return err
+	}
+
+	parts := []struct{ name, data string }{
+		{"content.xml", content},
+		{"styles.xml", odtStylesXML},
+		{"meta.xml", odtMetaXML(doc)},
+		{"META-INF/manifest.xml", odtManifestXML},
+	}
+
+	for _, part := range parts {
+		w, err := zw.Create(part.name)
+		if err != nil {
+			return err
+		}
+		if _, err := io.WriteString(w, part.data); err != nil {
+			return err
+		}
+	}
+
+	return zw.Close()
+}
+
+// contentXML assembles content.xml: title, table of contents and body, with
+// the automatic styles collected while rendering.
+func (c *ODTConverter) contentXML(doc *domain.OpenAPIDocument) string {
+	var b strings.Builder
+
+	b.WriteString(xml.Header)
+	b.WriteString(`<office:document-content ` + odtNamespaces + ` office:version="1.3">`)
+	b.WriteString(`<office:automatic-styles>`)
+	b.WriteString(odtTokenStyles())
+	b.WriteString(c.autoStyles.String())
+	b.WriteString(`</office:automatic-styles>`)
+	b.WriteString(`<office:body><office:text>`)
+
+	fmt.Fprintf(&b, `<text:p text:style-name="Title">%s</text:p>`, odtText(doc.Title))
+	fmt.Fprintf(&b, `<text:p text:style-name="Subtitle">%s</text:p>`, odtText("Version: "+doc.Version))
+	b.WriteString(c.tableOfContents())
+	b.WriteString(c.body.String())
+
+	b.WriteString(`</office:text></office:body></office:document-content>`)
+
+	return b.String()
+}
+
+// tableOfContents renders a TOC index over the collected headings. The index
+// body is prefilled with linked entries; page numbers appear once it is
+// updated in the editor.
+func (c *ODTConverter) tableOfContents() string {
+	var b strings.Builder
+
+	b.WriteString(`<text:table-of-content text:style-name="Sect1" text:protected="true" text:name="Table of Contents">`)
+	fmt.Fprintf(&b, `<text:table-of-content-source text:outline-level="%d">`, odtTOCLevels)
+	b.WriteString(`<text:index-title-template text:style-name="Contents_20_Heading">Contents</text:index-title-template>`)
+	for level := 1; level <= odtTOCLevels; level++ {
+		fmt.Fprintf(&b, `<text:table-of-content-entry-template text:outline-level="%d" text:style-name="Contents_20_%d">`, level, level)
+		b.WriteString(`<text:index-entry-link-start/><text:index-entry-chapter/><text:index-entry-text/>`)
+		b.WriteString(`<text:index-entry-tab-stop style:type="right" style:leader-char="."/><text:index-entry-page-number/><text:index-entry-link-end/>`)
+		b.WriteString(`</text:table-of-content-entry-template>`)
+	}
+	b.WriteString(`</text:table-of-content-source><text:index-body>`)
+	b.WriteString(`<text:index-title text:name="Table of Contents_Head"><text:p text:style-name="Contents_20_Heading">Contents</text:p></text:index-title>`)
+
+	for _, h := range c.headings {
+		if h.level > odtTOCLevels {
+			continue
+		}
+		fmt.Fprintf(&b, `<text:p text:style-name="Contents_20_%d"><text:a xlink:type="simple" xlink:href="#%s">%s</text:a></text:p>`,
+			h.level, h.anchor, odtText(h.text))
+	}
+
+	b.WriteString(`</text:index-body></text:table-of-content>`)
+
+	return b.String()
+}
+
+// anchor returns the bookmark name for a key, allocating one on first use so
+// links can be created before their target is rendered.
+func (c *ODTConverter) anchor(key string) string {
+	name, ok := c.anchors[key]
+	if !ok {
+		name = fmt.Sprintf("ocRef%d", len(c.anchors)+1)
+		c.anchors[key] = name
+	}
+
+	return name
+}
+
+// linkText renders a link as a hyperlink to its bookmark, or as text when it
+// has no target.
+func (c *ODTConverter) linkText(link layoutLink) string {
+	if link.key == "" {
+		return odtText(link.text)
+	}
+
+	return odtLink("#"+c.anchor(link.key), odtText(link.text))
+}
+
+// addHeading adds a heading with a bookmark for key; headings without a key
+// get one of their own so the table of contents can link to them.
+func (c *ODTConverter) addHeading(text string, level int, key string) {
+	if key == "" {
+		key = fmt.Sprintf("heading:%d", len(c.headings))
+	}
+	anchor := c.anchor(key)
+
+	c.headings = append(c.headings, odtHeading{text: text, level: level, anchor: anchor})
+	fmt.Fprintf(&c.body, `<text:h text:style-name="Heading_20_%d" text:outline-level="%d"><text:bookmark text:name="%s"/>%s</text:h>`,
+		level, level, anchor, odtText(text))
+}
+
+func (c *ODTConverter) addParagraph(style, content string) {
+	fmt.Fprintf(&c.body, `<text:p text:style-name="%s">%s</text:p>`, style, content)
+}
+
+func (c *ODTConverter) addDescription(section layoutSection, doc *domain.OpenAPIDocument) {
+	c.addHeading(section.title, 1, "")
+	c.addMarkdown(doc.Description)
+}
+
+func (c *ODTConverter) addSecurity(section layoutSection, doc *domain.OpenAPIDocument) {
+	c.addHeading(section.title, 1, "")
+
+	for _, name := range sortedKeys(doc.SecuritySchemes) {
+		scheme := doc.SecuritySchemes[name]
+
+		c.addHeading(name, 3, "")
+		c.addField("Type", scheme.Type)
+
+		if scheme.In != "" {
+			c.addField("In", scheme.In)
+		}
+		if scheme.Name != "" && scheme.Name != name {
+			c.addField("Name", scheme.Name)
+		}
+		if scheme.Scheme != "" {
+			c.addField("Scheme", scheme.Scheme)
+		}
+
+		if scheme.Description != "" {
+			c.addMarkdown(scheme.Description)
+		}
+	}
+}
+
+// addField adds a "Label: value" paragraph with a bold label.
+func (c *ODTConverter) addField(label, value string) {
+	c.addParagraph("Text_20_body", odtSpan("Strong_20_Emphasis", odtText(label+": "))+odtText(value))
+}
+
+func (c *ODTConverter) addServers(section layoutSection, doc *domain.OpenAPIDocument) {
+	c.addHeading(section.title, 1, "")
+
+	c.body.WriteString(`<text:list text:style-name="List_20_Bullet">`)
+	for _, server := range doc.Servers {
+		text := server.URL
+		if server.Description != "" {
+			text = fmt.Sprintf("%s - %s", server.URL, server.Description)
+		}
+
+		fmt.Fprintf(&c.body, `<text:list-item><text:p text:style-name="List_20_Bullet_20_Item">%s</text:p></text:list-item>`, odtText(text))
+	}
+	c.body.WriteString(`</text:list>`)
+}
+
+func (c *ODTConverter) addPaths(section layoutSection, layout *documentLayout) {
+	c.addHeading(section.title, 1, "")
+
+	for _, tag := range layout.tags {
+		c.currentTag = tag
+		c.addHeading(tag.name, 2, tag.key)
+
+		if tag.description != "" {
+			c.addMarkdown(tag.description)
+		}
+
+		c.addEndpointsSummary(tag)
+
+		if len(tag.components) > 0 {
+			c.addTagComponents(tag.components)
+		}
+
+		for _, ep := range tag.endpoints {
+			if !c.conv.startEndpoint(ep) {
+				return
+			}
+
+			c.addOperation(ep.path, ep.operation)
+		}
+	}
+}
+
+// addEndpointsSummary renders the endpoints table of a tag under a caption
+// paragraph.
+func (c *ODTConverter) addEndpointsSummary(tag layoutTag) {
+	if len(tag.endpoints) == 0 {
+		return
+	}
+
+	c.addParagraph("Caption", odtText(endpointsSummaryTitle))
+
+	rows := make([][]string, 0, len(tag.endpoints))
+	for _, row := range tag.summaryRows() {
+		rows = append(rows, []string{c.linkText(row.summary), c.linkText(row.path), odtText(formatMethod(row.method))})
+	}
+
+	c.addTable(endpointsSummaryWidths, endpointsSummaryHeaders, rows)
+}
+
+// addTable adds a table with a repeated header row. Widths are relative and
+// stretched to the text width; cells hold already-escaped paragraph content.
+func (c *ODTConverter) addTable(widths []float64, headers []string, rows [][]string) {
+	c.tables++
+	name := fmt.Sprintf("Table%d", c.tables)
+
+	var total float64
+	for _, w := range widths {
+		total += w
+	}
+
+	fmt.Fprintf(&c.autoStyles, `<style:style style:name="%s" style:family="table"><style:table-properties style:width="%.2fcm" table:align="margins"/></style:style>`,
+		name, odtTextWidth)
+	for i, w := range widths {
+		fmt.Fprintf(&c.autoStyles, `<style:style style:name="%s.C%d" style:family="table-column"><style:table-column-properties style:column-width="%.2fcm"/></style:style>`,
+			name, i, w/total*odtTextWidth)
+	}
+
+	fmt.Fprintf(&c.body, `<table:table table:name="%s" table:style-name="%s">`, name, name)
+	for i := range widths {
+		fmt.Fprintf(&c.body, `<table:table-column table:style-name="%s.C%d"/>`, name, i)
+	}
+
+	c.body.WriteString(`<table:table-header-rows><table:table-row>`)
+	for _, header := range headers {
+		fmt.Fprintf(&c.body, `<table:table-cell table:style-name="TableHeaderCell" office:value-type="string"><text:p text:style-name="Table_20_Heading">%s</text:p></table:table-cell>`,
+			odtText(header))
+	}
+	c.body.WriteString(`</table:table-row></table:table-header-rows>`)
+
+	for _, cells := range rows {
+		c.body.WriteString(`<table:table-row>`)
+		for _, cell := range cells {
+			fmt.Fprintf(&c.body, `<table:table-cell table:style-name="TableCell" office:value-type="string"><text:p text:style-name="Table_20_Contents">%s</text:p></table:table-cell>`,
+				cell)
+		}
+		c.body.WriteString(`</table:table-row>`)
+	}
+
+	c.body.WriteString(`</table:table>`)
+}
+
+// addTagComponents renders the component schemas used by endpoints in a tag.
+func (c *ODTConverter) addTagComponents(components []layoutComponent) {
+	c.addHeading("Schemas Used", 3, "")
+
+	for _, component := range components {
+		c.addComponentSchema(component.name, component.schema)
+	}
+}
+
+// addComponentSchema renders a single component schema.
+func (c *ODTConverter) addComponentSchema(name string, schema domain.Schema) {
+	c.addHeading(name, 4, componentKey(c.currentTag.name, name))
+
+	if schema.Type != "" {
+		typeStr := schema.Type
+		if schema.Format != "" {
+			typeStr = fmt.Sprintf("%s (%s)", schema.Type, schema.Format)
+		}
+		c.addParagraph("Text_20_body", odtText("Type: "+typeStr))
+	}
+
+	if schema.Description != "" {
+		c.addMarkdown(schema.Description)
+	}
+
+	if len(schema.Properties) > 0 {
+		rows := make([][]string, 0, len(schema.Properties))
+
+		for _, propName := range sortedKeys(schema.Properties) {
+			prop := schema.Properties[propName]
+
+			propType := odtText(prop.Type)
+			if prop.Ref != "" {
+				refName := extractRefName(prop.Ref)
+				propType = c.linkText(c.currentTag.componentLink(refName, refName))
+			} else if prop.Format != "" {
+				propType = odtText(fmt.Sprintf("%s (%s)", prop.Type, prop.Format))
+			}
+
+			rows = append(rows, []string{odtText(propName), propType, odtText(markdownToText(prop.Description))})
+		}
+
+		c.addTable([]float64{50, 50, 90}, []string{"Name", "Type", "Description"}, rows)
+	}
+}
+
+func (c *ODTConverter) addOperation(pathStr string, op domain.Operation) {
+	c.addHeading(fmt.Sprintf("%s %s", formatMethod(op.Method), pathStr), 3,
+		endpointKey(c.currentTag.name, op.Method, pathStr))
+
+	if op.Summary != "" {
+		c.addParagraph("Text_20_body", odtText(op.Summary))
+	}
+
+	if op.Description != "" {
+		c.addMarkdown(op.Description)
+	}
+
+	if len(op.Parameters) > 0 {
+		c.addHeading("Parameters", 4, "")
+		c.addParameterTable(op.Parameters)
+	}
+
+	if op.RequestBody != nil {
+		c.addHeading("Request Body", 4, "")
+		c.addRequestBody(op.RequestBody)
+	}
+
+	if len(op.Responses) > 0 {
+		c.addHeading("Responses", 4, "")
+		c.addResponseTable(op.Responses)
+	}
+
+	if examples := responseExamples(op.Responses, c.synth); len(examples) > 0 {
+		c.addHeading("Response Examples", 4, "")
+		for _, ex := range examples {
+			c.addExample(ex)
+		}
+	}
+}
+
+func (c *ODTConverter) addParameterTable(params []domain.Parameter) {
+	rows := make([][]string, 0, len(params))
+
+	for _, param := range params {
+		required := "No"
+		if param.Required {
+			required = "Yes"
+		}
+
+		schemaType := odtText(param.Schema.Type)
+		if param.Schema.Format != "" {
+			schemaType = odtText(fmt.Sprintf("%s (%s)", param.Schema.Type, param.Schema.Format))
+		}
+		if param.Schema.Ref != "" {
+			refName := extractRefName(param.Schema.Ref)
+			schemaType = c.linkText(c.currentTag.componentLink(refName, refName))
+		}
+
+		rows = append(rows, []string{
+			odtText(param.Name), odtText(param.In), odtText(required), schemaType, odtText(markdownToText(param.Description)),
+		})
+	}
+
+	c.addTable([]float64{35, 20, 15, 60, 60}, []string{"Name", "In", "Required", "Type", "Description"}, rows)
+}
+
+func (c *ODTConverter) addResponseTable(responses []domain.Response) {
+	sorted := make([]domain.Response, len(responses))
+	copy(sorted, responses)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].StatusCode < sorted[j].StatusCode
+	})
+
+	rows := make([][]string, 0, len(sorted))
+
+	for _, resp := range sorted {
+		object := ""
+		if contentTypes := sortedKeys(resp.Content); len(contentTypes) > 0 {
+			object = c.linkText(c.currentTag.componentLink(schemaTypeName(resp.Content[contentTypes[0]].Schema)))
+		}
+
+		rows = append(rows, []string{odtText(resp.StatusCode), odtText(markdownToText(resp.Description)), object})
+	}
+
+	c.addTable([]float64{25, 95, 70}, []string{"Status", "Description", "Object"}, rows)
+}
+
+func (c *ODTConverter) addRequestBody(rb *domain.RequestBody) {
+	if rb.Required {
+		c.addParagraph("Text_20_body", odtSpan("Emphasis", "Required"))
+	}
+
+	if rb.Description != "" {
+		c.addMarkdown(rb.Description)
+	}
+
+	if len(rb.Content) == 0 {
+		return
+	}
+
+	rows := make([][]string, 0, len(rb.Content))
+	for _, contentType := range sortedKeys(rb.Content) {
+		object := c.currentTag.componentLink(schemaTypeName(rb.Content[contentType].Schema))
+		rows = append(rows, []string{odtText(contentType), c.linkText(object)})
+	}
+
+	c.addTable([]float64{60, 130}, []string{"Content-Type", "Object"}, rows)
+
+	if examples := requestExamples(rb, c.synth); len(examples) > 0 {
+		c.addHeading("Request Examples", 4, "")
+		for _, ex := range examples {
+			c.addExample(ex)
+		}
+	}
+}
+
+// addExample renders a formatted example as line-numbered code paragraphs
+// with one colored span per token.
+func (c *ODTConverter) addExample(ex namedExample) {
+	c.addParagraph("Caption", odtText(ex.title))
+
+	code, lang := formatExample(ex.mediaType, ex.value)
+	lines := highlightExample(code, lang)
+	numWidth := len(fmt.Sprint(len(lines)))
+
+	for i, line := range lines {
+		var b strings.Builder
+		b.WriteString(odtSpan("LineNumber", odtText(fmt.Sprintf("%*d  ", numWidth, i+1))))
+
+		for _, tok := range line {
+			if tok.Kind == tokenPlain {
+				b.WriteString(odtText(tok.Text))
+				continue
+			}
+			b.WriteString(odtSpan(odtTokenStyle(tok.Kind), odtText(tok.Text)))
+		}
+
+		c.addParagraph("Source_20_Code", b.String())
+	}
+}
+
+// addMarkdown renders a CommonMark description as ODF paragraphs and lists.
+func (c *ODTConverter) addMarkdown(src string) {
+	c.addMarkdownBlocks(parseMarkdown(src))
+}
+
+func (c *ODTConverter) addMarkdownBlocks(blocks []mdBlock) {
+	c.body.WriteString(c.markdownBlocks(blocks, "Text_20_body"))
+}
+
+func (c *ODTConverter) markdownBlocks(blocks []mdBlock, paraStyle string) string {
+	var b strings.Builder
+
+	for _, block := range blocks {
+		switch block.Type {
+		case mdParagraph:
+			fmt.Fprintf(&b, `<text:p text:style-name="%s">%s</text:p>`, paraStyle, odtInlines(block.Inlines))
+
+		case mdHeading:
+			fmt.Fprintf(&b, `<text:p text:style-name="%s">%s</text:p>`, paraStyle,
+				odtSpan("Strong_20_Emphasis", odtInlines(block.Inlines)))
+
+		case mdBulletList, mdOrderedList:
+			listStyle, itemStyle := "List_20_Bullet", "List_20_Bullet_20_Item"
+			if block.Type == mdOrderedList {
+				listStyle, itemStyle = "Numbering_20_123", "List_20_Number_20_Item"
+			}
+
+			fmt.Fprintf(&b, `<text:list text:style-name="%s">`, listStyle)
+			for k, item := range block.Items {
+				if k == 0 && block.Type == mdOrderedList && block.Start > 1 {
+					fmt.Fprintf(&b, `<text:list-item text:start-value="%d">`, block.Start)
+				} else {
+					b.WriteString(`<text:list-item>`)
+				}
+				b.WriteString(c.markdownBlocks(item, itemStyle))
+				b.WriteString(`</text:list-item>`)
+			}
+			b.WriteString(`</text:list>`)
+
+		case mdCodeBlock:
+			for _, line := range strings.Split(block.Code, "\n") {
+				fmt.Fprintf(&b, `<text:p text:style-name="Source_20_Code">%s</text:p>`, odtText(line))
+			}
+
+		case mdQuote:
+			b.WriteString(c.markdownBlocks(block.Blocks, "Quotations"))
+
+		case mdRule:
+			b.WriteString(`<text:p text:style-name="Horizontal_20_Line"/>`)
+		}
+	}
+
+	return b.String()
+}
+
+// odtInlines renders styled text runs as nested spans.
+func odtInlines(inlines []mdInline) string {
+	var b strings.Builder
+
+	for _, in := range inlines {
+		text := odtText(in.Text)
+		if in.Code {
+			text = odtSpan("Source_20_Text", text)
+		}
+		if in.Italic {
+			text = odtSpan("Emphasis", text)
+		}
+		if in.Bold {
+			text = odtSpan("Strong_20_Emphasis", text)
+		}
+		if in.Link != "" {
+			text = odtLink(in.Link, text)
+		}
+
+		b.WriteString(text)
+	}
+
+	return b.String()
+}
+
+func odtSpan(style, content string) string {
+	return fmt.Sprintf(`<text:span text:style-name="%s">%s</text:span>`, style, content)
+}
+
+// odtLink wraps content in a hyperlink; internal targets start with "#".
+func odtLink(href, content string) string {
+	return fmt.Sprintf(`<text:a xlink:type="simple" xlink:href="%s" text:style-name="Internet_20_link" text:visited-style-name="Visited_20_Internet_20_Link">%s</text:a>`,
+		odtAttr(href), content)
+}
+
+// odtText escapes text for ODF content. Consecutive spaces, tabs and line
+// breaks would otherwise collapse, so they are written as their elements.
+func odtText(s string) string {
+	var b strings.Builder
+	spaces := 0
+
+	flush := func() {
+		if spaces == 0 {
+			return
+		}
+		b.WriteByte(' ')
+		if spaces > 1 {
+			fmt.Fprintf(&b, `<text:s text:c="%d"/>`, spaces-1)
+		}
+		spaces = 0
+	}
+
+	for _, r := range s {
+		switch r {
+		case ' ':
+			spaces++
+			continue
+		case '\t':
+			flush()
+			b.WriteString(`<text:tab/>`)
+			continue
+		case '\n':
+			flush()
+			b.WriteString(`<text:line-break/>`)
+			continue
+		}
+
+		flush()
+		_ = xml.EscapeText(&b, []byte(string(r)))
+	}
+	flush()
+
+	return b.String()
+}
+
+func odtAttr(s string) string {
+	var b strings.Builder
+	_ = xml.EscapeText(&b, []byte(s))
+
+	return b.String()
+}
+
+// odtTokenStyle returns the automatic text style of a token kind.
+func odtTokenStyle(kind tokenKind) string {
+	return fmt.Sprintf("Token%d", kind)
+}
+
+func odtTokenStyles() string {
+	var b strings.Builder
+
+	kinds := make([]tokenKind, 0, len(tokenColors))
+	for kind := range tokenColors {
+		kinds = append(kinds, kind)
+	}
+	sort.Slice(kinds, func(i, j int) bool { return kinds[i] < kinds[j] })
+
+	for _, kind := range kinds {
+		fmt.Fprintf(&b, `<style:style style:name="%s" style:family="text"><style:text-properties fo:color="#%s"/></style:style>`,
+			odtTokenStyle(kind), tokenHexColor(kind))
+	}
+	fmt.Fprintf(&b, `<style:style style:name="LineNumber" style:family="text"><style:text-properties fo:color="#%s"/></style:style>`,
+		docxLineNumberColor)
+	b.WriteString(`<style:style style:name="Sect1" style:family="section"><style:section-properties style:editable="false"/></style:style>`)
+
+	return b.String()
+}
+
+func odtMetaXML(doc *domain.OpenAPIDocument) string {
+	return xml.Header +
+		`<office:document-meta ` + odtNamespaces + ` office:version="1.3"><office:meta>` +
+		`<meta:generator>openapi-converter</meta:generator>` +
+		`<dc:title>` + odtAttr(doc.Title) + `</dc:title>` +
+		`</office:meta></office:document-meta>`
+}
+
+const odtManifestXML = xml.Header +
+	`<manifest:manifest xmlns:manifest="urn:oasis:names:tc:opendocument:xmlns:manifest:1.0" manifest:version="1.3">` +
+	`<manifest:file-entry manifest:full-path="/" manifest:version="1.3" manifest:media-type="application/vnd.oasis.opendocument.text"/>` +
+	`<manifest:file-entry manifest:full-path="content.xml" manifest:media-type="text/xml"/>` +
+	`<manifest:file-entry manifest:full-path="styles.xml" manifest:media-type="text/xml"/>` +
+	`<manifest:file-entry manifest:full-path="meta.xml" manifest:media-type="text/xml"/>` +
+	`</manifest:manifest>`
@@ -0,0 +1,95 @@
+package converters
+
+import "encoding/xml"
+
+// odtNamespaces declares the ODF namespaces used by the generated parts.
+const odtNamespaces = `xmlns:office="urn:oasis:names:tc:opendocument:xmlns:office:1.0" ` +
+	`xmlns:style="urn:oasis:names:tc:opendocument:xmlns:style:1.0" ` +
+	`xmlns:text="urn:oasis:names:tc:opendocument:xmlns:text:1.0" ` +
+	`xmlns:table="urn:oasis:names:tc:opendocument:xmlns:table:1.0" ` +
+	`xmlns:fo="urn:oasis:names:tc:opendocument:xmlns:xsl-fo-compatible:1.0" ` +
+	`xmlns:xlink="http://www.w3.org/1999/xlink" ` +
+	`xmlns:dc="http://purl.org/dc/elements/1.1/" ` +
+	`xmlns:meta="urn:oasis:names:tc:opendocument:xmlns:meta:1.0"`
+
+// odtStylesXML defines the named styles referenced by content.xml, using the
+// LibreOffice names so documents restyle like any other Writer document.
+const odtStylesXML = xml.Header +
+	`<office:document-styles ` + odtNamespaces + ` office:version="1.3">` +
+	`<office:font-face-decls xmlns:svg="urn:oasis:names:tc:opendocument:xmlns:svg-compatible:1.0">` +
+	`<style:font-face style:name="Liberation Sans" svg:font-family="'Liberation Sans'" style:font-family-generic="swiss"/>` +
+	`<style:font-face style:name="Liberation Mono" svg:font-family="'Liberation Mono'" style:font-family-generic="modern" style:font-pitch="fixed"/>` +
+	`</office:font-face-decls>` +
+	`<office:styles>` +
+	`<style:default-style style:family="paragraph"><style:paragraph-properties fo:margin-top="0cm" fo:margin-bottom="0cm"/>` +
+	`<style:text-properties style:font-name="Liberation Sans" fo:font-size="10pt"/></style:default-style>` +
+	`<style:style style:name="Standard" style:family="paragraph" style:class="text"/>` +
+	`<style:style style:name="Text_20_body" style:display-name="Text body" style:family="paragraph" style:parent-style-name="Standard" style:class="text">` +
+	`<style:paragraph-properties fo:margin-top="0cm" fo:margin-bottom="0.2cm"/></style:style>` +
+	`<style:style style:name="Title" style:family="paragraph" style:parent-style-name="Standard" style:class="chapter">` +
+	`<style:paragraph-properties fo:margin-bottom="0.2cm"/><style:text-properties fo:font-size="26pt" fo:font-weight="bold"/></style:style>` +
+	`<style:style style:name="Subtitle" style:family="paragraph" style:parent-style-name="Standard" style:class="chapter">` +
+	`<style:paragraph-properties fo:margin-bottom="0.5cm"/><style:text-properties fo:font-size="14pt" fo:color="#595959"/></style:style>` +
+	`<style:style style:name="Heading" style:family="paragraph" style:parent-style-name="Standard" style:next-style-name="Text_20_body" style:class="text">` +
+	`<style:paragraph-properties fo:margin-top="0.42cm" fo:margin-bottom="0.21cm" fo:keep-with-next="always"/>` +
+	`<style:text-properties fo:font-weight="bold"/></style:style>` +
+	`<style:style style:name="Heading_20_1" style:display-name="Heading 1" style:family="paragraph" style:parent-style-name="Heading" style:default-outline-level="1" style:class="text">` +
+	`<style:text-properties fo:font-size="18pt"/></style:style>` +
+	`<style:style style:name="Heading_20_2" style:display-name="Heading 2" style:family="paragraph" style:parent-style-name="Heading" style:default-outline-level="2" style:class="text">` +
+	`<style:text-properties fo:font-size="15pt"/></style:style>` +
+	`<style:style style:name="Heading_20_3" style:display-name="Heading 3" style:family="paragraph" style:parent-style-name="Heading" style:default-outline-level="3" style:class="text">` +
+	`<style:text-properties fo:font-size="13pt"/></style:style>` +
+	`<style:style style:name="Heading_20_4" style:display-name="Heading 4" style:family="paragraph" style:parent-style-name="Heading" style:default-outline-level="4" style:class="text">` +
+	`<style:text-properties fo:font-size="11pt"/></style:style>` +
+	`<style:style style:name="Caption" style:family="paragraph" style:parent-style-name="Standard" style:class="extra">` +
+	`<style:paragraph-properties fo:margin-top="0.2cm" fo:margin-bottom="0.1cm" fo:keep-with-next="always"/>` +
+	`<style:text-properties fo:font-size="9pt" fo:font-weight="bold" fo:color="#4F81BD"/></style:style>` +
+	`<style:style style:name="Quotations" style:family="paragraph" style:parent-style-name="Standard" style:class="html">` +
+	`<style:paragraph-properties fo:margin-left="1cm" fo:margin-right="1cm" fo:margin-bottom="0.2cm"/><style:text-properties fo:font-style="italic"/></style:style>` +
+	`<style:style style:name="Source_20_Code" style:display-name="Source Code" style:family="paragraph" style:parent-style-name="Standard" style:class="html">` +
+	`<style:paragraph-properties fo:background-color="#F5F5F5"/>` +
+	`<style:text-properties style:font-name="Liberation Mono" fo:font-size="9pt"/></style:style>` +
+	`<style:style style:name="Horizontal_20_Line" style:display-name="Horizontal Line" style:family="paragraph" style:parent-style-name="Standard" style:class="html">` +
+	`<style:paragraph-properties fo:margin-bottom="0.3cm" fo:border-bottom="0.5pt solid #B4B4B4"/></style:style>` +
+	`<style:style style:name="Table_20_Contents" style:display-name="Table Contents" style:family="paragraph" style:parent-style-name="Standard" style:class="extra"/>` +
+	`<style:style style:name="Table_20_Heading" style:display-name="Table Heading" style:family="paragraph" style:parent-style-name="Table_20_Contents" style:class="extra">` +
+	`<style:text-properties fo:font-weight="bold"/></style:style>` +
+	`<style:style style:name="List_20_Bullet_20_Item" style:display-name="List Bullet Item" style:family="paragraph" style:parent-style-name="Text_20_body" style:class="list"/>` +
+	`<style:style style:name="List_20_Number_20_Item" style:display-name="List Number Item" style:family="paragraph" style:parent-style-name="Text_20_body" style:class="list"/>` +
+	`<style:style style:name="Contents_20_Heading" style:display-name="Contents Heading" style:family="paragraph" style:parent-style-name="Heading" style:class="index">` +
+	`<style:text-properties fo:font-size="16pt"/></style:style>` +
+	`<style:style style:name="Contents_20_1" style:display-name="Contents 1" style:family="paragraph" style:parent-style-name="Standard" style:class="index"/>` +
+	`<style:style style:name="Contents_20_2" style:display-name="Contents 2" style:family="paragraph" style:parent-style-name="Standard" style:class="index">` +
+	`<style:paragraph-properties fo:margin-left="0.5cm"/></style:style>` +
+	`<style:style style:name="Contents_20_3" style:display-name="Contents 3" style:family="paragraph" style:parent-style-name="Standard" style:class="index">` +
+	`<style:paragraph-properties fo:margin-left="1cm"/></style:style>` +
+	`<style:style style:name="Strong_20_Emphasis" style:display-name="Strong Emphasis" style:family="text"><style:text-properties fo:font-weight="bold"/></style:style>` +
+	`<style:style style:name="Emphasis" style:family="text"><style:text-properties fo:font-style="italic"/></style:style>` +
+	`<style:style style:name="Source_20_Text" style:display-name="Source Text" style:family="text"><style:text-properties style:font-name="Liberation Mono"/></style:style>` +
+	`<style:style style:name="Internet_20_link" style:display-name="Internet link" style:family="text">` +
+	`<style:text-properties fo:color="#0563C1" style:text-underline-style="solid" style:text-underline-width="auto" style:text-underline-color="font-color"/></style:style>` +
+	`<style:style style:name="Visited_20_Internet_20_Link" style:display-name="Visited Internet Link" style:family="text">` +
+	`<style:text-properties fo:color="#800080" style:text-underline-style="solid" style:text-underline-width="auto" style:text-underline-color="font-color"/></style:style>` +
+	`<style:style style:name="TableHeaderCell" style:family="table-cell"><style:table-cell-properties fo:background-color="#E7E6E6" fo:padding="0.1cm" fo:border="0.5pt solid #B4B4B4"/></style:style>` +
+	`<style:style style:name="TableCell" style:family="table-cell"><style:table-cell-properties fo:padding="0.1cm" fo:border="0.5pt solid #B4B4B4"/></style:style>` +
+	`<text:list-style style:name="List_20_Bullet" style:display-name="List Bullet">` +
+	odtBulletLevels +
+	`</text:list-style>` +
+	`<text:list-style style:name="Numbering_20_123" style:display-name="Numbering 123">` +
+	odtNumberLevels +
+	`</text:list-style>` +
+	`</office:styles>` +
+	`<office:automatic-styles><style:page-layout style:name="pm1">` +
+	`<style:page-layout-properties fo:page-width="21cm" fo:page-height="29.7cm" style:print-orientation="portrait" ` +
+	`fo:margin-top="2cm" fo:margin-bottom="2cm" fo:margin-left="2cm" fo:margin-right="2cm"/>` +
+	`</style:page-layout></office:automatic-styles>` +
+	`<office:master-styles><style:master-page style:name="Standard" style:page-layout-name="pm1"/></office:master-styles>` +
+	`</office:document-styles>`
+
+const odtBulletLevels = `<text:list-level-style-bullet text:level="1" text:bullet-char="•"><style:list-level-properties text:list-level-position-and-space-mode="label-alignment"><style:list-level-label-alignment text:label-followed-by="listtab" text:list-tab-stop-position="0.635cm" fo:text-indent="-0.635cm" fo:margin-left="0.635cm"/></style:list-level-properties></text:list-level-style-bullet>` +
+	`<text:list-level-style-bullet text:level="2" text:bullet-char="◦"><style:list-level-properties text:list-level-position-and-space-mode="label-alignment"><style:list-level-label-alignment text:label-followed-by="listtab" text:list-tab-stop-position="1.27cm" fo:text-indent="-0.635cm" fo:margin-left="1.27cm"/></style:list-level-properties></text:list-level-style-bullet>` +
+	`<text:list-level-style-bullet text:level="3" text:bullet-char="▪"><style:list-level-properties text:list-level-position-and-space-mode="label-alignment"><style:list-level-label-alignment text:label-followed-by="listtab" text:list-tab-stop-position="1.905cm" fo:text-indent="-0.635cm" fo:margin-left="1.905cm"/></style:list-level-properties></text:list-level-style-bullet>`
+
+const odtNumberLevels = `<text:list-level-style-number text:level="1" style:num-suffix="." style:num-format="1"><style:list-level-properties text:list-level-position-and-space-mode="label-alignment"><style:list-level-label-alignment text:label-followed-by="listtab" text:list-tab-stop-position="0.635cm" fo:text-indent="-0.635cm" fo:margin-left="0.635cm"/></style:list-level-properties></text:list-level-style-number>` +
+	`<text:list-level-style-number text:level="2" style:num-suffix="." style:num-format="a"><style:list-level-properties text:list-level-position-and-space-mode="label-alignment"><style:list-level-label-alignment text:label-followed-by="listtab" text:list-tab-stop-position="1.27cm" fo:text-indent="-0.635cm" fo:margin-left="1.27cm"/></style:list-level-properties></text:list-level-style-number>` +
+	`<text:list-level-style-number text:level="3" style:num-suffix="." style:num-format="i"><style:list-level-properties text:list-level-position-and-space-mode="label-alignment"><style:list-level-label-alignment text:label-followed-by="listtab" text:list-tab-stop-position="1.905cm" fo:text-indent="-0.635cm" fo:margin-left="1.905cm"/></style:list-level-properties></text:list-level-style-number>`
@@ -1,10 +1,11 @@
 package converters
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
 	"io"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/GabrielNunesIT/openapi-converter/internal/domain"
@@ -12,21 +13,90 @@ import (
 )
 
 const (
-	pdfFormat      = "pdf"
-	pdfPageWidth   = 190.0
-	pdfMarginLeft  = 10.0
-	pdfMarginTop   = 10.0
-	pdfMarginRight = 10.0
-	pdfLineHeight  = 5.0
+	pdfFormat     = "pdf"
+	pdfLineHeight = 5.0
+
+	// pdfBaseContentWidth is the A4 portrait content width the column layouts are designed against.
+	pdfBaseContentWidth = 190.0
+	// pdfMinContentWidth is the narrowest content area that still fits the endpoint tables.
+	pdfMinContentWidth = 80.0
+
+	pdfListIndent     = 5.0
+	pdfCodeLineHeight = 4.0
+	pdfBullet         = "\x95" // Bullet in the cp1252 encoding used by the core fonts
+)
+
+// PDF page sizes supported by the PDF converter.
+const (
+	PDFPageA3     = "A3"
+	PDFPageA4     = "A4"
+	PDFPageA5     = "A5"
+	PDFPageLetter = "Letter"
+	PDFPageLegal  = "Legal"
+)
+
+// PDF page orientations supported by the PDF converter.
+const (
+	PDFPortrait  = "portrait"
+	PDFLandscape = "landscape"
 )
 
+// PDFMargins holds the page margins in millimetres.
+type PDFMargins struct {
+	Top    float64
+	Right  float64
+	Bottom float64
+	Left   float64
+}
+
 // PDFConverter converts OpenAPI documents to PDF format.
 type PDFConverter struct {
-	pdf            *gofpdf.Fpdf
-	tocItems       []tocItem
-	linkID         int
-	componentLinks map[string]int // Map "tag:component" to link ID
-	currentTag     string         // Current tag context for link resolution
+	pdf        *gofpdf.Fpdf
+	tocItems   []tocItem
+	linkID     int
+	links      map[string]int // Map anchor keys to link IDs
+	currentTag layoutTag      // Current tag context for link resolution
+	conv       *conversion    // Progress of the current conversion
+
+	pageSize     string
+	orientation  string
+	margins      PDFMargins
+	contentWidth float64 // Page width minus left and right margins
+
+	generateExamples bool
+	synth            *exampleSynthesizer // Set per conversion when generateExamples is enabled
+}
+
+// PDFOption configures a PDFConverter.
+type PDFOption func(*PDFConverter)
+
+// WithPageSize sets the paper size (A3, A4, A5, Letter or Legal).
+func WithPageSize(size string) PDFOption {
+	return func(c *PDFConverter) {
+		c.pageSize = size
+	}
+}
+
+// WithOrientation sets the page orientation (portrait or landscape).
+func WithOrientation(orientation string) PDFOption {
+	return func(c *PDFConverter) {
+		c.orientation = orientation
+	}
+}
+
+// WithMargins sets the page margins in millimetres.
+func WithMargins(margins PDFMargins) PDFOption {
+	return func(c *PDFConverter) {
+		c.margins = margins
+	}
+}
+
+// WithPDFGeneratedExamples enables synthesizing examples from schemas for
+// request and response bodies that have none.
+func WithPDFGeneratedExamples(enabled bool) PDFOption {
+	return func(c *PDFConverter) {
+		c.generateExamples = enabled
+	}
 }
 
 type tocItem struct {
@@ -37,8 +107,18 @@ type tocItem struct {
 }
 
 // NewPDFConverter creates a new PDF converter.
-func NewPDFConverter() *PDFConverter {
-	return &PDFConverter{}
+func NewPDFConverter(opts ...PDFOption) *PDFConverter {
+	c := &PDFConverter{
+		pageSize:    PDFPageA4,
+		orientation: PDFPortrait,
+		margins:     PDFMargins{Top: 10, Right: 10, Bottom: 20, Left: 10},
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
 }
 
 // Format returns the output format name.
@@ -48,16 +128,34 @@ func (c *PDFConverter) Format() string {
 
 // Convert transforms an OpenAPI document to PDF format.
 func (c *PDFConverter) Convert(doc *domain.OpenAPIDocument, output io.Writer) error {
-	c.pdf = gofpdf.New("P", "mm", "A4", "")
-	c.pdf.SetMargins(pdfMarginLeft, pdfMarginTop, pdfMarginRight)
+	_, err := c.ConvertContext(context.Background(), doc, output, domain.ConvertOptions{})
+
+	return err
+}
+
+// ConvertContext implements domain.ContextConverter.
+func (c *PDFConverter) ConvertContext(ctx context.Context, doc *domain.OpenAPIDocument, output io.Writer,
+	opts domain.ConvertOptions,
+) ([]domain.Warning, error) {
+	if err := c.setupPage(); err != nil {
+		return nil, err
+	}
+
 	c.pdf.SetDrawColor(180, 180, 180) // Light gray for all borders
 	c.tocItems = nil
 	c.linkID = 0
-	c.componentLinks = make(map[string]int)
-	c.currentTag = ""
+	c.links = make(map[string]int)
+	c.currentTag = layoutTag{}
+	c.synth = nil
+	if c.generateExamples {
+		c.synth = newExampleSynthesizer(doc.Components)
+	}
+
+	layout := newDocumentLayout(doc)
+	c.conv = newConversion(ctx, layout, opts)
 
 	// First pass: collect TOC items with placeholder pages
-	c.collectTOC(doc)
+	c.collectTOC(layout)
 
 	// Title page
 	c.addTitlePage(doc)
@@ -66,139 +164,126 @@ func (c *PDFConverter) Convert(doc *domain.OpenAPIDocument, output io.Writer) er
 	c.addTableOfContents()
 
 	// Content pages
-	c.addContent(doc)
-
-	return c.pdf.Output(output)
-}
+	c.addContent(layout, doc)
 
-func (c *PDFConverter) collectTOC(doc *domain.OpenAPIDocument) {
-	// Add main sections to TOC
-	c.tocItems = append(c.tocItems, tocItem{title: "Overview", level: 1, linkID: c.pdf.AddLink()})
-
-	if len(doc.SecuritySchemes) > 0 {
-		c.tocItems = append(c.tocItems, tocItem{title: "Authentication", level: 1, linkID: c.pdf.AddLink()})
+	if err := c.conv.finish(); err != nil {
+		return c.conv.warnings, err
 	}
 
-	if len(doc.Servers) > 0 {
-		c.tocItems = append(c.tocItems, tocItem{title: "Servers", level: 1, linkID: c.pdf.AddLink()})
-	}
+	return c.conv.warnings, c.pdf.Output(output)
+}
 
-	// Group paths by tags
-	tagPaths := c.groupPathsByTag(doc)
-	tags := make([]string, 0, len(tagPaths))
-	for tag := range tagPaths {
-		tags = append(tags, tag)
+// setupPage creates the PDF document with the configured page geometry.
+func (c *PDFConverter) setupPage() error {
+	size, err := parsePDFPageSize(c.pageSize)
+	if err != nil {
+		return err
 	}
-	sort.Strings(tags)
 
-	// Pre-create links for all tag+component combinations
-	for _, tag := range tags {
-		tagComponents := c.collectTagComponents(tagPaths[tag])
-		for _, compName := range tagComponents {
-			key := tag + ":" + compName
-			c.componentLinks[key] = c.pdf.AddLink()
-		}
+	orientation, err := parsePDFOrientation(c.orientation)
+	if err != nil {
+		return err
 	}
 
-	// Add Endpoints section
-	c.tocItems = append(c.tocItems, tocItem{title: "API Endpoints", level: 1, linkID: c.pdf.AddLink()})
+	m := c.margins
+	if m.Top < 0 || m.Right < 0 || m.Bottom < 0 || m.Left < 0 {
+		return fmt.Errorf("invalid margins: values must not be negative")
+	}
 
-	for _, tag := range tags {
-		c.tocItems = append(c.tocItems, tocItem{title: tag, level: 2, linkID: c.pdf.AddLink()})
+	c.pdf = gofpdf.New(orientation, "mm", size, "")
+	c.pdf.SetMargins(m.Left, m.Top, m.Right)
+	c.pdf.SetAutoPageBreak(true, m.Bottom)
 
-		for _, ep := range tagPaths[tag] {
-			title := fmt.Sprintf("%s %s", ep.method, ep.path)
-			c.tocItems = append(c.tocItems, tocItem{title: title, level: 3, linkID: c.pdf.AddLink()})
-		}
+	pageWidth, _ := c.pdf.GetPageSize()
+	c.contentWidth = pageWidth - m.Left - m.Right
+	if c.contentWidth < pdfMinContentWidth {
+		return fmt.Errorf("margins leave only %.1fmm of content width (minimum %.0fmm)", c.contentWidth, pdfMinContentWidth)
 	}
-}
 
-// collectTagComponents gathers all unique component names used by endpoints in a tag.
-func (c *PDFConverter) collectTagComponents(endpoints []endpointRef) []string {
-	componentSet := make(map[string]struct{})
+	return nil
+}
 
-	for _, ep := range endpoints {
-		// Check request body
-		if ep.operation.RequestBody != nil {
-			for _, media := range ep.operation.RequestBody.Content {
-				c.collectSchemaRefs(media.Schema, componentSet)
-			}
-		}
+// ValidatePDFPageSize reports an error when a page size is not supported.
+func ValidatePDFPageSize(size string) error {
+	_, err := parsePDFPageSize(size)
+	return err
+}
 
-		// Check responses
-		for _, resp := range ep.operation.Responses {
-			for _, media := range resp.Content {
-				c.collectSchemaRefs(media.Schema, componentSet)
-			}
-		}
+// ValidatePDFOrientation reports an error when an orientation is not supported.
+func ValidatePDFOrientation(orientation string) error {
+	_, err := parsePDFOrientation(orientation)
+	return err
+}
 
-		// Check parameters
-		for _, param := range ep.operation.Parameters {
-			c.collectSchemaRefs(param.Schema, componentSet)
+// parsePDFPageSize validates a page size name and returns the gofpdf size string.
+func parsePDFPageSize(size string) (string, error) {
+	for _, known := range []string{PDFPageA3, PDFPageA4, PDFPageA5, PDFPageLetter, PDFPageLegal} {
+		if strings.EqualFold(size, known) {
+			return known, nil
 		}
 	}
 
-	// Convert set to sorted slice
-	components := make([]string, 0, len(componentSet))
-	for name := range componentSet {
-		components = append(components, name)
-	}
-	sort.Strings(components)
-	return components
+	return "", fmt.Errorf("unsupported page size: %s (supported: A3, A4, A5, Letter, Legal)", size)
 }
 
-// collectSchemaRefs recursively collects component references from a schema.
-func (c *PDFConverter) collectSchemaRefs(schema domain.Schema, refs map[string]struct{}) {
-	if schema.Ref != "" {
-		refs[extractRefName(schema.Ref)] = struct{}{}
+// parsePDFOrientation validates an orientation name and returns the gofpdf orientation code.
+func parsePDFOrientation(orientation string) (string, error) {
+	switch strings.ToLower(orientation) {
+	case PDFPortrait, "p", "":
+		return "P", nil
+	case PDFLandscape, "l":
+		return "L", nil
+	default:
+		return "", fmt.Errorf("unsupported orientation: %s (supported: portrait, landscape)", orientation)
 	}
+}
 
-	for _, prop := range schema.Properties {
-		c.collectSchemaRefs(prop, refs)
+// scaleWidths converts column widths designed for the A4 portrait layout into widths
+// that fill the effective content width, keeping their proportions.
+func (c *PDFConverter) scaleWidths(baseWidths ...float64) []float64 {
+	widths := make([]float64, len(baseWidths))
+	for i, w := range baseWidths {
+		widths[i] = w * c.contentWidth / pdfBaseContentWidth
 	}
 
-	if schema.Items != nil {
-		c.collectSchemaRefs(*schema.Items, refs)
-	}
+	return widths
 }
 
-type endpointRef struct {
-	path      string
-	method    string
-	operation domain.Operation
-}
+// collectTOC lists the table of contents entries and creates the links of
+// everything that can be linked to, so links can be added before their
+// targets are rendered.
+func (c *PDFConverter) collectTOC(layout *documentLayout) {
+	for _, section := range layout.sections {
+		c.addTOCItem(section.title, 1, section.key)
+
+		if section.kind != sectionEndpoints {
+			continue
+		}
 
-func (c *PDFConverter) groupPathsByTag(doc *domain.OpenAPIDocument) map[string][]endpointRef {
-	result := make(map[string][]endpointRef)
+		for _, tag := range layout.tags {
+			c.addTOCItem(tag.name, 2, tag.key)
 
-	for _, path := range doc.Paths {
-		for _, op := range path.Operations {
-			tags := op.Tags
-			if len(tags) == 0 {
-				tags = []string{"Default"}
+			for _, ep := range tag.endpoints {
+				c.addTOCItem(fmt.Sprintf("%s %s", ep.method, ep.path), 3, ep.key)
 			}
 
-			for _, tag := range tags {
-				result[tag] = append(result[tag], endpointRef{
-					path:      path.Path,
-					method:    op.Method,
-					operation: op,
-				})
+			for _, component := range tag.components {
+				c.links[component.key] = c.pdf.AddLink()
 			}
 		}
 	}
+}
 
-	// Sort endpoints within each tag by path then method
-	for tag := range result {
-		sort.Slice(result[tag], func(i, j int) bool {
-			if result[tag][i].path == result[tag][j].path {
-				return result[tag][i].method < result[tag][j].method
-			}
-			return result[tag][i].path < result[tag][j].path
-		})
-	}
+func (c *PDFConverter) addTOCItem(title string, level int, key string) {
+	linkID := c.pdf.AddLink()
+	c.links[key] = linkID
+	c.tocItems = append(c.tocItems, tocItem{title: title, level: level, linkID: linkID})
+}
 
-	return result
+// componentLink returns the link to a component schema rendered in the
+// current tag, or 0 when the tag does not render it.
+func (c *PDFConverter) componentLink(refName string) int {
+	return c.links[c.currentTag.componentLink(refName, refName).key]
 }
 
 func (c *PDFConverter) addTitlePage(doc *domain.OpenAPIDocument) {
@@ -207,22 +292,22 @@ func (c *PDFConverter) addTitlePage(doc *domain.OpenAPIDocument) {
 	// Title
 	c.pdf.SetFont("Arial", "B", 28)
 	c.pdf.Ln(40)
-	c.pdf.CellFormat(pdfPageWidth, 15, doc.Title, "", 1, "C", false, 0, "")
+	c.pdf.CellFormat(c.contentWidth, 15, doc.Title, "", 1, "C", false, 0, "")
 	c.pdf.Ln(5)
 
 	// Version
 	c.pdf.SetFont("Arial", "", 14)
 	c.pdf.SetTextColor(100, 100, 100)
-	c.pdf.CellFormat(pdfPageWidth, 8, fmt.Sprintf("Version %s", doc.Version), "", 1, "C", false, 0, "")
+	c.pdf.CellFormat(c.contentWidth, 8, fmt.Sprintf("Version %s", doc.Version), "", 1, "C", false, 0, "")
 	c.pdf.SetTextColor(0, 0, 0)
 	c.pdf.Ln(20)
 
 	// Description
 	if doc.Description != "" {
 		c.pdf.SetFont("Arial", "", 11)
-		// Clean HTML from description
-		desc := stripHTML(doc.Description)
-		c.pdf.MultiCell(pdfPageWidth, 6, desc, "", "C", false)
+		// Rich formatting cannot be centered, so the title page shows plain text
+		desc := markdownToText(doc.Description)
+		c.pdf.MultiCell(c.contentWidth, 6, desc, "", "C", false)
 	}
 
 	c.pdf.Ln(30)
@@ -230,7 +315,7 @@ func (c *PDFConverter) addTitlePage(doc *domain.OpenAPIDocument) {
 	// API Info
 	c.pdf.SetFont("Arial", "", 10)
 	c.pdf.SetTextColor(128, 128, 128)
-	c.pdf.CellFormat(pdfPageWidth, 6, "OpenAPI Specification Document", "", 1, "C", false, 0, "")
+	c.pdf.CellFormat(c.contentWidth, 6, "OpenAPI Specification Document", "", 1, "C", false, 0, "")
 	c.pdf.SetTextColor(0, 0, 0)
 }
 
@@ -238,7 +323,7 @@ func (c *PDFConverter) addTableOfContents() {
 	c.pdf.AddPage()
 
 	c.pdf.SetFont("Arial", "B", 20)
-	c.pdf.CellFormat(pdfPageWidth, 10, "Table of Contents", "", 1, "", false, 0, "")
+	c.pdf.CellFormat(c.contentWidth, 10, "Table of Contents", "", 1, "", false, 0, "")
 	c.pdf.Ln(8)
 
 	for _, item := range c.tocItems {
@@ -254,186 +339,178 @@ func (c *PDFConverter) addTableOfContents() {
 		}
 
 		// Title with link
-		c.pdf.SetX(pdfMarginLeft + indent)
-		title := item.title
-		if len(title) > 60 {
-			title = title[:57] + "..."
-		}
-		c.pdf.CellFormat(pdfPageWidth-indent, pdfLineHeight, title, "", 1, "", false, item.linkID, "")
+		c.pdf.SetX(c.margins.Left + indent)
+		title := c.fitWidth(item.title, c.contentWidth-indent)
+		c.pdf.CellFormat(c.contentWidth-indent, pdfLineHeight, title, "", 1, "", false, item.linkID, "")
 	}
 }
 
-func (c *PDFConverter) addContent(doc *domain.OpenAPIDocument) {
-	tocIndex := 0
-
-	// Overview section
-	c.pdf.AddPage()
-	c.setLinkDest(tocIndex)
-	tocIndex++
-
-	c.addSectionHeader("Overview")
+// fitWidth shortens text with an ellipsis so that it fits on one line of a
+// cell of the given width in the current font.
+func (c *PDFConverter) fitWidth(text string, width float64) string {
+	// Leaves room for the cell padding
+	width -= 2 * c.pdf.GetCellMargin()
+	if c.pdf.GetStringWidth(text) <= width {
+		return text
+	}
 
-	if doc.Description != "" {
-		c.pdf.SetFont("Arial", "", 10)
-		c.pdf.MultiCell(pdfPageWidth, 5, stripHTML(doc.Description), "", "", false)
-		c.pdf.Ln(4)
+	runes := []rune(text)
+	for len(runes) > 0 && c.pdf.GetStringWidth(string(runes)+"...") > width {
+		runes = runes[:len(runes)-1]
 	}
 
-	// Authentication section
-	if len(doc.SecuritySchemes) > 0 {
-		c.checkPageBreak(40)
-		c.setLinkDest(tocIndex)
-		tocIndex++
+	return string(runes) + "..."
+}
 
-		c.addSectionHeader("Authentication")
+func (c *PDFConverter) addContent(layout *documentLayout, doc *domain.OpenAPIDocument) {
+	for i, section := range layout.sections {
+		if !c.conv.startSection(section) {
+			return
+		}
 
-		// Sort schemes by name for consistent output
-		schemeNames := make([]string, 0, len(doc.SecuritySchemes))
-		for name := range doc.SecuritySchemes {
-			schemeNames = append(schemeNames, name)
+		// The endpoints start on a page of their own
+		if i == 0 || section.kind == sectionEndpoints {
+			c.pdf.AddPage()
+		} else {
+			c.checkPageBreak(40)
 		}
-		sort.Strings(schemeNames)
 
-		for _, name := range schemeNames {
-			scheme := doc.SecuritySchemes[name]
-			c.pdf.SetFont("Arial", "B", 10)
-			c.pdf.CellFormat(pdfPageWidth, 6, name, "", 1, "", false, 0, "")
+		c.setLinkDest(section.key)
+		c.addSectionHeader(section.title)
 
+		switch section.kind {
+		case sectionDescription:
 			c.pdf.SetFont("Arial", "", 10)
-			
-			// Type
-			c.pdf.CellFormat(30, 6, "Type:", "", 0, "", false, 0, "")
-			c.pdf.CellFormat(0, 6, scheme.Type, "", 1, "", false, 0, "")
-
-			// In (if apiKey)
-			if scheme.In != "" {
-				c.pdf.CellFormat(30, 6, "In:", "", 0, "", false, 0, "")
-				c.pdf.CellFormat(0, 6, scheme.In, "", 1, "", false, 0, "")
-			}
-
-			// Name (if apiKey)
-			if scheme.Name != "" && scheme.Name != name {
-				c.pdf.CellFormat(30, 6, "Name:", "", 0, "", false, 0, "")
-				c.pdf.CellFormat(0, 6, scheme.Name, "", 1, "", false, 0, "")
-			}
-
-            // Scheme (if http)
-			if scheme.Scheme != "" {
-				c.pdf.CellFormat(30, 6, "Scheme:", "", 0, "", false, 0, "")
-				c.pdf.CellFormat(0, 6, scheme.Scheme, "", 1, "", false, 0, "")
-			}
-			
-			if scheme.Description != "" {
-				c.pdf.Ln(2)
-				c.pdf.MultiCell(pdfPageWidth, 5, stripHTML(scheme.Description), "", "", false)
-			}
+			c.addMarkdown(doc.Description, 10, 5)
 			c.pdf.Ln(4)
+		case sectionAuthentication:
+			c.addSecurity(doc.SecuritySchemes)
+		case sectionServers:
+			c.addServers(doc.Servers)
+		case sectionEndpoints:
+			c.pdf.Ln(4)
+			c.addTags(layout.tags)
 		}
 	}
+}
 
-	// Servers
-	if len(doc.Servers) > 0 {
-		c.checkPageBreak(40)
-		c.setLinkDest(tocIndex)
-		tocIndex++
+func (c *PDFConverter) addSecurity(schemes map[string]domain.SecurityScheme) {
+	for _, name := range sortedKeys(schemes) {
+		scheme := schemes[name]
+		c.pdf.SetFont("Arial", "B", 10)
+		c.pdf.CellFormat(c.contentWidth, 6, name, "", 1, "", false, 0, "")
 
-		c.addSectionHeader("Servers")
+		c.pdf.SetFont("Arial", "", 10)
 
-		for _, server := range doc.Servers {
-			c.pdf.SetFont("Arial", "B", 10)
-			c.pdf.SetTextColor(0, 102, 204)
-			c.pdf.CellFormat(pdfPageWidth, 6, server.URL, "", 1, "", false, 0, "")
-			c.pdf.SetTextColor(0, 0, 0)
+		// Type
+		c.pdf.CellFormat(30, 6, "Type:", "", 0, "", false, 0, "")
+		c.pdf.CellFormat(0, 6, scheme.Type, "", 1, "", false, 0, "")
 
-			if server.Description != "" {
-				c.pdf.SetFont("Arial", "", 9)
-				c.pdf.SetTextColor(100, 100, 100)
-				c.pdf.MultiCell(pdfPageWidth, 4, server.Description, "", "", false)
-				c.pdf.SetTextColor(0, 0, 0)
-			}
-			c.pdf.Ln(2)
+		// In (if apiKey)
+		if scheme.In != "" {
+			c.pdf.CellFormat(30, 6, "In:", "", 0, "", false, 0, "")
+			c.pdf.CellFormat(0, 6, scheme.In, "", 1, "", false, 0, "")
 		}
-		c.pdf.Ln(4)
-	}
 
-	// API Endpoints header
-	c.pdf.AddPage()
-	c.setLinkDest(tocIndex)
-	tocIndex++
+		// Name (if apiKey)
+		if scheme.Name != "" && scheme.Name != name {
+			c.pdf.CellFormat(30, 6, "Name:", "", 0, "", false, 0, "")
+			c.pdf.CellFormat(0, 6, scheme.Name, "", 1, "", false, 0, "")
+		}
 
-	c.addSectionHeader("API Endpoints")
-	c.pdf.Ln(4)
+		// Scheme (if http)
+		if scheme.Scheme != "" {
+			c.pdf.CellFormat(30, 6, "Scheme:", "", 0, "", false, 0, "")
+			c.pdf.CellFormat(0, 6, scheme.Scheme, "", 1, "", false, 0, "")
+		}
 
-	// Create lookup for tag descriptions
-	tagDescs := make(map[string]string)
-	for _, t := range doc.Tags {
-		tagDescs[t.Name] = t.Description
+		if scheme.Description != "" {
+			c.pdf.Ln(2)
+			c.addMarkdown(scheme.Description, 10, 5)
+		}
+		c.pdf.Ln(4)
 	}
+}
+
+func (c *PDFConverter) addServers(servers []domain.Server) {
+	for _, server := range servers {
+		c.pdf.SetFont("Arial", "B", 10)
+		c.pdf.SetTextColor(0, 102, 204)
+		c.pdf.CellFormat(c.contentWidth, 6, server.URL, "", 1, "", false, 0, "")
+		c.pdf.SetTextColor(0, 0, 0)
 
-	// Group by tags
-	tagPaths := c.groupPathsByTag(doc)
-	tags := make([]string, 0, len(tagPaths))
-	for tag := range tagPaths {
-		tags = append(tags, tag)
+		if server.Description != "" {
+			c.pdf.SetFont("Arial", "", 9)
+			c.pdf.SetTextColor(100, 100, 100)
+			c.pdf.MultiCell(c.contentWidth, 4, server.Description, "", "", false)
+			c.pdf.SetTextColor(0, 0, 0)
+		}
+		c.pdf.Ln(2)
 	}
-	sort.Strings(tags)
+	c.pdf.Ln(4)
+}
 
+// addTags renders each tag on a page of its own: its endpoints, followed by
+// the component schemas they use.
+func (c *PDFConverter) addTags(tags []layoutTag) {
 	for _, tag := range tags {
 		c.pdf.AddPage()
-		c.setLinkDest(tocIndex)
-		tocIndex++
+		c.setLinkDest(tag.key)
 
 		// Tag header
 		c.pdf.SetFont("Arial", "B", 14)
 		c.pdf.SetFillColor(240, 240, 240)
-		c.pdf.CellFormat(pdfPageWidth, 8, tag, "", 1, "", true, 0, "")
+		c.pdf.CellFormat(c.contentWidth, 8, tag.name, "", 1, "", true, 0, "")
 		c.pdf.Ln(4)
 
 		// Set current tag context for link resolution
 		c.currentTag = tag
 
 		// Tag description
-		if desc, ok := tagDescs[tag]; ok && desc != "" {
+		if tag.description != "" {
 			c.pdf.SetFont("Arial", "", 10)
-			c.pdf.MultiCell(pdfPageWidth, 5, stripHTML(desc), "", "", false)
+			c.addMarkdown(tag.description, 10, 5)
 			c.pdf.Ln(4)
 		}
 
 		// Endpoints Summary
-		c.addEndpointsSummary(tagPaths[tag], tocIndex)
+		c.addEndpointsSummary(tag)
 		c.pdf.Ln(6)
 
-		for _, ep := range tagPaths[tag] {
+		for _, ep := range tag.endpoints {
+			if !c.conv.startEndpoint(ep) {
+				return
+			}
+
 			c.checkPageBreak(50)
-			c.setLinkDest(tocIndex)
-			tocIndex++
+			c.setLinkDest(ep.key)
 
 			c.addEndpoint(ep.path, ep.operation)
 		}
 
 		// Add components used by this tag's endpoints at the bottom
-		tagComponents := c.collectTagComponents(tagPaths[tag])
-		if len(tagComponents) > 0 {
+		if len(tag.components) > 0 {
 			c.pdf.Ln(6)
 			c.pdf.SetDrawColor(180, 180, 180)
-			c.pdf.Line(pdfMarginLeft, c.pdf.GetY(), pdfMarginLeft+pdfPageWidth, c.pdf.GetY())
+			c.pdf.Line(c.margins.Left, c.pdf.GetY(), c.margins.Left+c.contentWidth, c.pdf.GetY())
 			c.pdf.Ln(6)
-			c.addTagComponents(tag, tagComponents, doc.Components)
+			c.addTagComponents(tag.components)
 		}
 
 		c.pdf.Ln(4)
 	}
 }
 
-func (c *PDFConverter) setLinkDest(tocIndex int) {
-	if tocIndex < len(c.tocItems) {
-		c.pdf.SetLink(c.tocItems[tocIndex].linkID, -1, -1)
+// setLinkDest makes the current position the target of the link of key.
+func (c *PDFConverter) setLinkDest(key string) {
+	if linkID, ok := c.links[key]; ok {
+		c.pdf.SetLink(linkID, -1, -1)
 	}
 }
 
 func (c *PDFConverter) addSectionHeader(title string) {
 	c.pdf.SetFont("Arial", "B", 18)
-	c.pdf.CellFormat(pdfPageWidth, 10, title, "", 1, "", false, 0, "")
+	c.pdf.CellFormat(c.contentWidth, 10, title, "", 1, "", false, 0, "")
 	c.pdf.Ln(4)
 }
 
@@ -464,28 +541,26 @@ func (c *PDFConverter) addEndpoint(pathStr string, op domain.Operation) {
 	// Path
 	c.pdf.SetTextColor(0, 0, 0)
 	c.pdf.SetFont("Arial", "B", 11)
-	c.pdf.CellFormat(pdfPageWidth-methodWidth, 7, " "+pathStr, "", 1, "", false, 0, "")
+	c.pdf.CellFormat(c.contentWidth-methodWidth, 7, " "+pathStr, "", 1, "", false, 0, "")
 	c.pdf.Ln(2)
 
 	// Operation ID
 	if op.OperationID != "" {
 		c.pdf.SetFont("Arial", "", 8)
 		c.pdf.SetTextColor(128, 128, 128)
-		c.pdf.CellFormat(pdfPageWidth, 4, fmt.Sprintf("Operation ID: %s", op.OperationID), "", 1, "", false, 0, "")
+		c.pdf.CellFormat(c.contentWidth, 4, fmt.Sprintf("Operation ID: %s", op.OperationID), "", 1, "", false, 0, "")
 		c.pdf.SetTextColor(0, 0, 0)
 	}
 
 	// Summary
 	if op.Summary != "" {
 		c.pdf.SetFont("Arial", "B", 10)
-		c.pdf.MultiCell(pdfPageWidth, 5, stripHTML(op.Summary), "", "", false)
+		c.pdf.MultiCell(c.contentWidth, 5, stripHTML(op.Summary), "", "", false)
 	}
 
 	// Description
 	if op.Description != "" {
-		c.pdf.SetFont("Arial", "", 9)
-		desc := stripHTML(op.Description)
-		c.pdf.MultiCell(pdfPageWidth, 4, desc, "", "", false)
+		c.addMarkdown(op.Description, 9, 4)
 	}
 	c.pdf.Ln(2)
 
@@ -510,7 +585,7 @@ func (c *PDFConverter) addEndpoint(pathStr string, op domain.Operation) {
 	// Separator
 	c.pdf.Ln(2)
 	c.pdf.SetDrawColor(220, 220, 220)
-	c.pdf.Line(pdfMarginLeft, c.pdf.GetY(), pdfMarginLeft+pdfPageWidth, c.pdf.GetY())
+	c.pdf.Line(c.margins.Left, c.pdf.GetY(), c.margins.Left+c.contentWidth, c.pdf.GetY())
 	c.pdf.SetDrawColor(180, 180, 180) // Reset to standard light gray
 	c.pdf.Ln(6)
 }
@@ -518,7 +593,7 @@ func (c *PDFConverter) addEndpoint(pathStr string, op domain.Operation) {
 func (c *PDFConverter) addSubHeader(title string) {
 	c.pdf.SetFont("Arial", "B", 10)
 	c.pdf.SetTextColor(60, 60, 60)
-	c.pdf.CellFormat(pdfPageWidth, 6, title, "", 1, "", false, 0, "")
+	c.pdf.CellFormat(c.contentWidth, 6, title, "", 1, "", false, 0, "")
 	c.pdf.SetTextColor(0, 0, 0)
 }
 
@@ -527,7 +602,7 @@ func (c *PDFConverter) addParameterTable(params []domain.Parameter) {
 	c.pdf.SetFont("Arial", "B", 8)
 	c.pdf.SetFillColor(245, 245, 245)
 
-	colWidths := []float64{35, 20, 15, 60, 60}
+	colWidths := c.scaleWidths(35, 20, 15, 60, 60)
 	headers := []string{"Name", "In", "Required", "Type", "Description"}
 
 	for i, header := range headers {
@@ -551,7 +626,7 @@ func (c *PDFConverter) addParameterTable(params []domain.Parameter) {
 			schemaType = extractRefName(param.Schema.Ref)
 		}
 
-		desc := stripHTML(param.Description)
+		desc := markdownToText(param.Description)
 
 		contents := []string{param.Name, param.In, required, schemaType, desc}
 		aligns := []string{"L", "L", "C", "L", "L"}
@@ -565,13 +640,12 @@ func (c *PDFConverter) addRequestBody(rb *domain.RequestBody) {
 	if rb.Required {
 		c.pdf.SetFont("Arial", "I", 9)
 		c.pdf.SetTextColor(60, 60, 60)
-		c.pdf.CellFormat(pdfPageWidth, 5, "Required", "", 1, "", false, 0, "")
+		c.pdf.CellFormat(c.contentWidth, 5, "Required", "", 1, "", false, 0, "")
 		c.pdf.SetTextColor(0, 0, 0)
 	}
 
 	if rb.Description != "" {
-		c.pdf.SetFont("Arial", "", 9)
-		c.pdf.MultiCell(pdfPageWidth, 4, stripHTML(rb.Description), "", "", false)
+		c.addMarkdown(rb.Description, 9, 4)
 	}
 
 	// Content types
@@ -580,7 +654,7 @@ func (c *PDFConverter) addRequestBody(rb *domain.RequestBody) {
 		c.pdf.SetFont("Arial", "B", 8)
 		c.pdf.SetFillColor(245, 245, 245)
 
-		colWidths := []float64{60, 130}
+		colWidths := c.scaleWidths(60, 130)
 		headers := []string{"Content-Type", "Object"}
 
 		for i, header := range headers {
@@ -596,12 +670,6 @@ func (c *PDFConverter) addRequestBody(rb *domain.RequestBody) {
 		}
 		sort.Strings(contentTypes)
 
-		type bodyExample struct {
-			title   string
-			content interface{}
-		}
-		var examples []bodyExample
-
 		for _, contentType := range contentTypes {
 			media := rb.Content[contentType]
 			
@@ -611,8 +679,7 @@ func (c *PDFConverter) addRequestBody(rb *domain.RequestBody) {
 			if media.Schema.Ref != "" {
 				refName := extractRefName(media.Schema.Ref)
 				objectStr = refName
-				key := c.currentTag + ":" + refName
-				linkID = c.componentLinks[key]
+				linkID = c.componentLink(refName)
 			} else {
 				objectStr = media.Schema.Type
 				if media.Schema.Format != "" {
@@ -623,8 +690,7 @@ func (c *PDFConverter) addRequestBody(rb *domain.RequestBody) {
 					if media.Schema.Items.Ref != "" {
 						refName := extractRefName(media.Schema.Items.Ref)
 						itemType = refName
-						key := c.currentTag + ":" + refName
-						linkID = c.componentLinks[key]
+						linkID = c.componentLink(refName)
 					}
 					objectStr = fmt.Sprintf("[]%s", itemType)
 				}
@@ -639,27 +705,13 @@ func (c *PDFConverter) addRequestBody(rb *domain.RequestBody) {
 			
 			c.addTableRow(colWidths, contents, aligns, linkIDs)
 
-			if media.Example != nil {
-				examples = append(examples, bodyExample{title: contentType, content: media.Example})
-			}
-
-			if len(media.Examples) > 0 {
-				names := make([]string, 0, len(media.Examples))
-                for name := range media.Examples {
-                    names = append(names, name)
-                }
-                sort.Strings(names)
-                for _, name := range names {
-					examples = append(examples, bodyExample{title: fmt.Sprintf("%s (%s)", contentType, name), content: media.Examples[name]})
-				}
-			}
 		}
 
-		if len(examples) > 0 {
+		if examples := requestExamples(rb, c.synth); len(examples) > 0 {
 			c.pdf.Ln(4)
 			c.addSubHeader("Request Examples")
 			for _, ex := range examples {
-				c.addExample(ex.title, ex.content)
+				c.addExample(ex)
 			}
 		}
 	}
@@ -672,10 +724,9 @@ func (c *PDFConverter) addSchemaInfo(schema domain.Schema, indent int) {
 
 	if schema.Ref != "" {
 		refName := extractRefName(schema.Ref)
-		key := c.currentTag + ":" + refName
-		linkID := c.componentLinks[key]
+		linkID := c.componentLink(refName)
 		c.pdf.SetTextColor(0, 102, 204)
-		c.pdf.CellFormat(pdfPageWidth, 4, fmt.Sprintf("%sObject: %s", indentStr, refName), "", 1, "", false, linkID, "")
+		c.pdf.CellFormat(c.contentWidth, 4, fmt.Sprintf("%sObject: %s", indentStr, refName), "", 1, "", false, linkID, "")
 		c.pdf.SetTextColor(0, 0, 0)
 		return
 	}
@@ -686,34 +737,34 @@ func (c *PDFConverter) addSchemaInfo(schema domain.Schema, indent int) {
 	}
 
 	if schemaType != "" && schemaType != "object" {
-		c.pdf.CellFormat(pdfPageWidth, 4, fmt.Sprintf("%sType: %s", indentStr, schemaType), "", 1, "", false, 0, "")
+		c.pdf.CellFormat(c.contentWidth, 4, fmt.Sprintf("%sType: %s", indentStr, schemaType), "", 1, "", false, 0, "")
 	}
 
 	if schema.Description != "" {
-		desc := stripHTML(schema.Description)
-		
+		desc := markdownToText(schema.Description)
+
 		// Handle indentation for description
 		indentWidth := c.pdf.GetStringWidth(strings.Repeat("  ", indent))
 		currentX := c.pdf.GetX()
 		c.pdf.SetX(currentX + indentWidth)
-		c.pdf.MultiCell(pdfPageWidth-indentWidth, 4, desc, "", "", false)
+		c.pdf.MultiCell(c.contentWidth-indentWidth, 4, desc, "", "", false)
 	}
 
 	// Properties
 	if len(schema.Properties) > 0 {
-		c.pdf.CellFormat(pdfPageWidth, 4, fmt.Sprintf("%sProperties:", indentStr), "", 1, "", false, 0, "")
+		c.pdf.CellFormat(c.contentWidth, 4, fmt.Sprintf("%sProperties:", indentStr), "", 1, "", false, 0, "")
 		for name, prop := range schema.Properties {
 			propType := prop.Type
 			if prop.Ref != "" {
 				propType = extractRefName(prop.Ref)
 			}
-			c.pdf.CellFormat(pdfPageWidth, 4, fmt.Sprintf("%s  - %s: %s", indentStr, name, propType), "", 1, "", false, 0, "")
+			c.pdf.CellFormat(c.contentWidth, 4, fmt.Sprintf("%s  - %s: %s", indentStr, name, propType), "", 1, "", false, 0, "")
 		}
 	}
 
 	// Array items
 	if schema.Items != nil {
-		c.pdf.CellFormat(pdfPageWidth, 4, fmt.Sprintf("%sItems:", indentStr), "", 1, "", false, 0, "")
+		c.pdf.CellFormat(c.contentWidth, 4, fmt.Sprintf("%sItems:", indentStr), "", 1, "", false, 0, "")
 		c.addSchemaInfo(*schema.Items, indent+1)
 	}
 }
@@ -728,7 +779,7 @@ func (c *PDFConverter) addResponseTable(responses []domain.Response) {
 	c.pdf.SetFont("Arial", "B", 8)
 	c.pdf.SetFillColor(245, 245, 245)
 
-	colWidths := []float64{25, 95, 70}
+	colWidths := c.scaleWidths(25, 95, 70)
 	headers := []string{"Status", "Description", "Object"}
 
 	for i, header := range headers {
@@ -739,7 +790,7 @@ func (c *PDFConverter) addResponseTable(responses []domain.Response) {
 	// Table rows
 	c.pdf.SetFont("Arial", "", 8)
 	for _, resp := range responses {
-		desc := stripHTML(resp.Description)
+		desc := markdownToText(resp.Description)
 
 		// Get schema reference
 		schemaRef := ""
@@ -748,8 +799,7 @@ func (c *PDFConverter) addResponseTable(responses []domain.Response) {
 			if media.Schema.Ref != "" {
 				refName := extractRefName(media.Schema.Ref)
 				schemaRef = refName
-				key := c.currentTag + ":" + refName
-				schemaLinkID = c.componentLinks[key]
+				schemaLinkID = c.componentLink(refName)
 
 				break
 			} else if media.Schema.Type != "" {
@@ -771,45 +821,11 @@ func (c *PDFConverter) addResponseTable(responses []domain.Response) {
 		c.addTableRow(colWidths, contents, aligns, linkIDs)
 	}
 
-	// Gather examples from responses to display after table
-	type respExample struct {
-		title   string
-		content interface{}
-	}
-	var examples []respExample
-
-	for _, resp := range responses {
-		for mediaType, media := range resp.Content {
-            // First check if there is a single example
-			if media.Example != nil {
-				examples = append(examples, respExample{
-					title:   fmt.Sprintf("%s - %s", resp.StatusCode, mediaType),
-					content: media.Example,
-				})
-			}
-            // Also check for named examples
-            if len(media.Examples) > 0 {
-                // To keep order consistent
-                names := make([]string, 0, len(media.Examples))
-                for name := range media.Examples {
-                    names = append(names, name)
-                }
-                sort.Strings(names)
-                for _, name := range names {
-                    examples = append(examples, respExample{
-                        title:   fmt.Sprintf("%s - %s (%s)", resp.StatusCode, mediaType, name),
-                        content: media.Examples[name],
-                    })
-                }
-            }
-		}
-	}
-
-	if len(examples) > 0 {
+	if examples := responseExamples(responses, c.synth); len(examples) > 0 {
 		c.pdf.Ln(4)
 		c.addSubHeader("Response Examples")
 		for _, ex := range examples {
-			c.addExample(ex.title, ex.content)
+			c.addExample(ex)
 		}
 	}
 
@@ -825,6 +841,106 @@ func (c *PDFConverter) checkPageBreak(height float64) {
 	}
 }
 
+// addMarkdown renders a CommonMark description at the current position using
+// the given base font size and line height.
+func (c *PDFConverter) addMarkdown(src string, fontSize, lineHeight float64) {
+	c.addMarkdownBlocks(parseMarkdown(src), fontSize, lineHeight, 0, false)
+	c.pdf.SetFont("Arial", "", fontSize)
+}
+
+//nolint:cyclop // One case per block type
+func (c *PDFConverter) addMarkdownBlocks(blocks []mdBlock, fontSize, lineHeight, indent float64, continueLine bool) {
+	left := c.margins.Left + indent
+	width := c.contentWidth - indent
+
+	for i, block := range blocks {
+		if i > 0 {
+			c.pdf.Ln(lineHeight / 2)
+		}
+		if i > 0 || !continueLine {
+			c.pdf.SetX(left)
+		}
+
+		switch block.Type {
+		case mdParagraph:
+			c.writeInlines(block.Inlines, fontSize, lineHeight, left)
+			c.pdf.Ln(lineHeight)
+
+		case mdHeading:
+			headingSize := fontSize + float64(max(0, 4-block.Level))
+			inlines := make([]mdInline, len(block.Inlines))
+			for k, in := range block.Inlines {
+				in.Bold = true
+				inlines[k] = in
+			}
+			c.writeInlines(inlines, headingSize, lineHeight+1, left)
+			c.pdf.Ln(lineHeight + 1)
+
+		case mdBulletList, mdOrderedList:
+			for n, item := range block.Items {
+				marker := pdfBullet
+				if block.Type == mdOrderedList {
+					marker = fmt.Sprintf("%d.", block.Start+n)
+				}
+				c.pdf.SetFont("Arial", "", fontSize)
+				c.pdf.SetX(left)
+				c.pdf.CellFormat(pdfListIndent, lineHeight, marker, "", 0, "", false, 0, "")
+				c.addMarkdownBlocks(item, fontSize, lineHeight, indent+pdfListIndent, true)
+			}
+
+		case mdCodeBlock:
+			c.pdf.SetFont("Courier", "", fontSize-1)
+			c.pdf.SetFillColor(245, 245, 245)
+			c.pdf.MultiCell(width, lineHeight, block.Code, "", "", true)
+
+		case mdQuote:
+			startY := c.pdf.GetY()
+			c.addMarkdownBlocks(block.Blocks, fontSize, lineHeight, indent+pdfListIndent, false)
+			c.pdf.SetDrawColor(200, 200, 200)
+			c.pdf.Line(left+1, startY, left+1, c.pdf.GetY())
+			c.pdf.SetDrawColor(180, 180, 180)
+
+		case mdRule:
+			c.pdf.SetDrawColor(220, 220, 220)
+			c.pdf.Line(left, c.pdf.GetY(), left+width, c.pdf.GetY())
+			c.pdf.SetDrawColor(180, 180, 180)
+			c.pdf.Ln(1)
+		}
+	}
+}
+
+// writeInlines writes styled runs, wrapping lines at the given left edge.
+func (c *PDFConverter) writeInlines(inlines []mdInline, fontSize, lineHeight, left float64) {
+	r, g, b := c.pdf.GetTextColor()
+	c.pdf.SetLeftMargin(left)
+
+	for _, in := range inlines {
+		family, style, size := "Arial", "", fontSize
+		if in.Code {
+			family, size = "Courier", fontSize-0.5
+		}
+		if in.Bold {
+			style += "B"
+		}
+		if in.Italic {
+			style += "I"
+		}
+
+		if in.Link != "" {
+			c.pdf.SetFont(family, style+"U", size)
+			c.pdf.SetTextColor(0, 102, 204)
+			c.pdf.WriteLinkString(lineHeight, in.Text, in.Link)
+			c.pdf.SetTextColor(r, g, b)
+			continue
+		}
+
+		c.pdf.SetFont(family, style, size)
+		c.pdf.Write(lineHeight, in.Text)
+	}
+
+	c.pdf.SetLeftMargin(c.margins.Left)
+}
+
 func stripHTML(s string) string {
 	// Simple HTML tag removal
 	result := s
@@ -860,7 +976,7 @@ func extractRefName(ref string) string {
 func (c *PDFConverter) addComponentSchema(name string, schema domain.Schema) {
 	// Component name as Title
 	c.pdf.SetFont("Arial", "B", 12)
-	c.pdf.CellFormat(pdfPageWidth, 7, name, "", 1, "", false, 0, "")
+	c.pdf.CellFormat(c.contentWidth, 7, name, "", 1, "", false, 0, "")
 
 	// Type
 	if schema.Type != "" && schema.Type != "object" {
@@ -869,15 +985,13 @@ func (c *PDFConverter) addComponentSchema(name string, schema domain.Schema) {
 		if schema.Format != "" {
 			typeStr = fmt.Sprintf("%s (%s)", schema.Type, schema.Format)
 		}
-		c.pdf.CellFormat(pdfPageWidth, 5, fmt.Sprintf("Type: %s", typeStr), "", 1, "", false, 0, "")
+		c.pdf.CellFormat(c.contentWidth, 5, fmt.Sprintf("Type: %s", typeStr), "", 1, "", false, 0, "")
 	}
 
 	// Description
 	if schema.Description != "" {
-		c.pdf.SetFont("Arial", "", 9)
 		c.pdf.SetTextColor(100, 100, 100)
-		desc := stripHTML(schema.Description)
-		c.pdf.MultiCell(pdfPageWidth, 4, desc, "", "", false)
+		c.addMarkdown(schema.Description, 9, 4)
 		c.pdf.SetTextColor(0, 0, 0)
 	}
 
@@ -888,12 +1002,12 @@ func (c *PDFConverter) addComponentSchema(name string, schema domain.Schema) {
 		// Component Name Header
 		c.pdf.SetFont("Arial", "B", 9)
 		c.pdf.SetFillColor(245, 245, 245)
-		c.pdf.CellFormat(pdfPageWidth, 6, name, "1", 1, "C", true, 0, "")
+		c.pdf.CellFormat(c.contentWidth, 6, name, "1", 1, "C", true, 0, "")
 
 		// Table header
 		c.pdf.SetFont("Arial", "B", 8)
 		c.pdf.SetFillColor(245, 245, 245)
-		propColWidths := []float64{50, 50, 90}
+		propColWidths := c.scaleWidths(50, 50, 90)
 		propHeaders := []string{"Name", "Type", "Description"}
 
 		for i, header := range propHeaders {
@@ -917,13 +1031,12 @@ func (c *PDFConverter) addComponentSchema(name string, schema domain.Schema) {
 			if prop.Ref != "" {
 				refName := extractRefName(prop.Ref)
 				propType = refName
-				key := c.currentTag + ":" + refName
-				propLinkID = c.componentLinks[key]
+				propLinkID = c.componentLink(refName)
 			} else if prop.Format != "" {
 				propType = fmt.Sprintf("%s (%s)", prop.Type, prop.Format)
 			}
 
-			propDesc := stripHTML(prop.Description)
+			propDesc := markdownToText(prop.Description)
 
 			contents := []string{propName, propType, propDesc}
 			aligns := []string{"L", "L", "L"}
@@ -937,34 +1050,24 @@ func (c *PDFConverter) addComponentSchema(name string, schema domain.Schema) {
 }
 
 // addTagComponents renders the component schemas used by endpoints in a tag.
-func (c *PDFConverter) addTagComponents(tag string, componentNames []string, components map[string]domain.Schema) {
+func (c *PDFConverter) addTagComponents(components []layoutComponent) {
 	c.pdf.SetFont("Arial", "B", 11)
 	c.pdf.SetTextColor(60, 60, 60)
-	c.pdf.CellFormat(pdfPageWidth, 6, "Objects Used", "", 1, "", false, 0, "")
+	c.pdf.CellFormat(c.contentWidth, 6, "Objects Used", "", 1, "", false, 0, "")
 	c.pdf.SetTextColor(0, 0, 0)
 	c.pdf.Ln(2)
 
-	for _, name := range componentNames {
-		schema, exists := components[name]
-		if !exists {
-			continue
-		}
-
+	for _, component := range components {
 		c.checkPageBreak(30)
+		c.setLinkDest(component.key)
 
-		// Set the link destination for this tag+component
-		key := tag + ":" + name
-		if linkID, ok := c.componentLinks[key]; ok {
-			c.pdf.SetLink(linkID, -1, -1)
-		}
-
-		c.addComponentSchema(name, schema)
+		c.addComponentSchema(component.name, component.schema)
 	}
 
 	// Separator after components
 	c.pdf.Ln(2)
 	c.pdf.SetDrawColor(180, 180, 180)
-	c.pdf.Line(pdfMarginLeft, c.pdf.GetY(), pdfMarginLeft+pdfPageWidth, c.pdf.GetY())
+	c.pdf.Line(c.margins.Left, c.pdf.GetY(), c.margins.Left+c.contentWidth, c.pdf.GetY())
 	c.pdf.Ln(6)
 }
 
@@ -1022,78 +1125,113 @@ func (c *PDFConverter) addTableRow(colWidths []float64, contents []string, align
 	}
 	
 	// Move cursor to next row
-	c.pdf.SetXY(pdfMarginLeft, startY+rowHeight)
+	c.pdf.SetXY(c.margins.Left, startY+rowHeight)
 }
 
-func (c *PDFConverter) addEndpointsSummary(endpoints []endpointRef, startTocIndex int) {
-	if len(endpoints) == 0 {
+func (c *PDFConverter) addEndpointsSummary(tag layoutTag) {
+	if len(tag.endpoints) == 0 {
 		return
 	}
 
 	c.pdf.SetFont("Arial", "B", 11)
-	c.pdf.CellFormat(pdfPageWidth, 6, "Endpoints in this section", "", 1, "", false, 0, "")
+	c.pdf.CellFormat(c.contentWidth, 6, endpointsSummaryTitle, "", 1, "", false, 0, "")
 	c.pdf.Ln(2)
 
 	// Table header
 	c.pdf.SetFont("Arial", "B", 9)
 	c.pdf.SetFillColor(245, 245, 245)
 
-	colWidths := []float64{100, 75, 15}
-	headers := []string{"Summary", "Path", "Method"}
+	colWidths := c.scaleWidths(endpointsSummaryWidths...)
 
-	for i, header := range headers {
+	for i, header := range endpointsSummaryHeaders {
 		c.pdf.CellFormat(colWidths[i], 6, header, "1", 0, "", true, 0, "")
 	}
 	c.pdf.Ln(-1)
 
 	// Table rows
 	c.pdf.SetFont("Arial", "", 9)
-	currentTocIndex := startTocIndex
 
-	for _, ep := range endpoints {
-		summary := stripHTML(ep.operation.Summary)
-		if len(summary) > 60 {
-			summary = summary[:57] + "..."
-		}
+	for _, row := range tag.summaryRows() {
+		summary := c.fitWidth(row.summary.text, colWidths[0])
 
-		contents := []string{summary, ep.path, ep.method}
+		contents := []string{summary, row.path.text, row.method}
 		aligns := []string{"L", "L", "C"}
-		
-		var linkIDs []int
-		if currentTocIndex < len(c.tocItems) {
-			linkID := c.tocItems[currentTocIndex].linkID
-			linkIDs = []int{linkID, linkID, linkID}
-		}
-		
-		c.addTableRow(colWidths, contents, aligns, linkIDs)
-		currentTocIndex++
+
+		linkID := c.links[row.path.key]
+
+		c.addTableRow(colWidths, contents, aligns, []int{linkID, linkID, linkID})
 	}
 }
 
-func (c *PDFConverter) addExample(title string, example interface{}) {
+// addExample renders a formatted, syntax-highlighted example with line numbers.
+// Long examples continue on the following pages line by line.
+func (c *PDFConverter) addExample(ex namedExample) {
 	c.checkPageBreak(30) // Ensure enough space or break
 
 	c.pdf.SetFont("Arial", "I", 9)
 	c.pdf.SetTextColor(60, 60, 60)
-	c.pdf.CellFormat(pdfPageWidth, 6, "Example ("+title+"):", "", 1, "", false, 0, "")
+	c.pdf.CellFormat(c.contentWidth, 6, "Example ("+ex.title+"):", "", 1, "", false, 0, "")
+
+	code, lang := formatExample(ex.mediaType, ex.value)
+	lines := highlightExample(code, lang)
 
 	c.pdf.SetFont("Courier", "", 8)
-	c.pdf.SetTextColor(0, 0, 0)
-	c.pdf.SetFillColor(250, 250, 250)
+	gutter := c.pdf.GetStringWidth(strconv.Itoa(len(lines))) + 4
+	maxChars := max(int((c.contentWidth-gutter-2)/c.pdf.GetStringWidth("m")), 1)
+	left := c.margins.Left
+
+	for i, line := range lines {
+		for k, segment := range wrapTokens(line, maxChars) {
+			c.checkPageBreak(pdfCodeLineHeight)
+			y := c.pdf.GetY()
+
+			c.pdf.SetFillColor(248, 248, 248)
+			c.pdf.Rect(left, y, c.contentWidth, pdfCodeLineHeight, "F")
+			c.pdf.SetFillColor(236, 236, 236)
+			c.pdf.Rect(left, y, gutter, pdfCodeLineHeight, "F")
+
+			// Only the first segment of a wrapped line is numbered
+			if k == 0 {
+				c.pdf.SetTextColor(150, 150, 150)
+				c.pdf.SetXY(left, y)
+				c.pdf.CellFormat(gutter-1, pdfCodeLineHeight, strconv.Itoa(i+1), "", 0, "R", false, 0, "")
+			}
 
-	var content string
-	if b, err := json.MarshalIndent(example, "", "  "); err == nil {
-		content = string(b)
-	} else {
-		content = fmt.Sprintf("%v", example)
-	}
+			c.pdf.SetXY(left+gutter+1, y)
+			for _, token := range segment {
+				color := tokenColors[token.Kind]
+				c.pdf.SetTextColor(color[0], color[1], color[2])
+				c.pdf.CellFormat(c.pdf.GetStringWidth(token.Text), pdfCodeLineHeight, token.Text, "", 0, "", false, 0, "")
+			}
 
-	// Calculate height
-	lines := strings.Split(content, "\n")
-	height := float64(len(lines)) * 4.0 // 4.0 is likely not enough for MultiCell, usually line height.
-	// MultiCell line height is passed as argument, 4 here.
-	c.checkPageBreak(height + 2)
+			c.pdf.SetXY(left, y+pdfCodeLineHeight)
+		}
+	}
 
-	c.pdf.MultiCell(pdfPageWidth, 4, content, "1", "", true)
+	c.pdf.SetTextColor(0, 0, 0)
 	c.pdf.Ln(4)
 }
+
+// wrapTokens splits a highlighted line into segments of at most maxChars characters.
+func wrapTokens(line []exampleToken, maxChars int) [][]exampleToken {
+	segments := [][]exampleToken{nil}
+	used := 0
+
+	for _, token := range line {
+		text := []rune(token.Text)
+		for len(text) > 0 {
+			if used == maxChars {
+				segments = append(segments, nil)
+				used = 0
+			}
+
+			n := min(len(text), maxChars-used)
+			last := len(segments) - 1
+			segments[last] = append(segments[last], exampleToken{Kind: token.Kind, Text: string(text[:n])})
+			text = text[n:]
+			used += n
+		}
+	}
+
+	return segments
+}
@@ -0,0 +1,246 @@
+package converters
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/GabrielNunesIT/openapi-converter/internal/domain"
+)
+
+// Settings holds the options a converter may be created with. Converters
+// ignore the settings they do not support.
+type Settings struct {
+	GenerateExamples bool
+
+	// PDF page layout
+	PageSize    string
+	Orientation string
+	Margins     *PDFMargins
+
+	// DOCX template document
+	ReferenceDoc string
+
+	// Go templates of the template format
+	TemplateFiles []string
+	TemplateEntry string
+}
+
+// Factory creates a converter with the given settings.
+type Factory func(settings Settings) domain.Converter
+
+// Format describes an output format and how to create its converter.
+type Format struct {
+	Name        string   // Name returned by the converter's Format method
+	Aliases     []string // Other names accepted for the format
+	Extension   string   // Output file extension, including the dot
+	MIMEType    string
+	Description string
+	New         Factory
+
+	// Plugin marks formats converted by an external program, which servers
+	// only offer when told to
+	Plugin bool
+}
+
+// Registry holds the available output formats.
+type Registry struct {
+	formats []Format
+	names   map[string]int // Lower-case names and aliases to indexes in formats
+}
+
+// NewRegistry creates a registry holding the given formats.
+func NewRegistry(formats ...Format) (*Registry, error) {
+	r := &Registry{names: make(map[string]int)}
+
+	for _, format := range formats {
+		if err := r.Register(format); err != nil {
+			return nil, err
+		}
+	}
+
+	return r, nil
+}
+
+// DefaultRegistry returns a registry of the built-in formats.
+func DefaultRegistry() *Registry {
+	r, err := NewRegistry(builtinFormats()...)
+	if err != nil {
+		panic(err) // The built-in formats are fixed
+	}
+
+	return r
+}
+
+// Register adds a format. Its name and aliases must not be in use.
+func (r *Registry) Register(format Format) error {
+	if format.Name == "" || format.New == nil {
+		return fmt.Errorf("format %q needs a name and a factory", format.Name)
+	}
+
+	names := append([]string{format.Name}, format.Aliases...)
+	for _, name := range names {
+		if _, ok := r.names[strings.ToLower(name)]; ok {
+			return fmt.Errorf("format name %q is already registered", name)
+		}
+	}
+
+	for _, name := range names {
+		r.names[strings.ToLower(name)] = len(r.formats)
+	}
+	r.formats = append(r.formats, format)
+
+	return nil
+}
+
+// Lookup returns the format with the given name or alias.
+func (r *Registry) Lookup(name string) (Format, bool) {
+	i, ok := r.names[strings.ToLower(name)]
+	if !ok {
+		return Format{}, false
+	}
+
+	return r.formats[i], true
+}
+
+// ForFile returns the format whose extension a file name ends with. The
+// longest extension wins, so ".adf.json" is matched before any ".json".
+func (r *Registry) ForFile(fileName string) (Format, bool) {
+	name := strings.ToLower(filepath.Base(fileName))
+
+	var (
+		found Format
+		ok    bool
+	)
+
+	for _, format := range r.formats {
+		ext := strings.ToLower(format.Extension)
+		if ext == "" || len(ext) >= len(name) || !strings.HasSuffix(name, ext) {
+			continue
+		}
+
+		if !ok || len(ext) > len(found.Extension) {
+			found, ok = format, true
+		}
+	}
+
+	return found, ok
+}
+
+// New creates the converter of a format.
+func (r *Registry) New(name string, settings Settings) (domain.Converter, error) {
+	format, ok := r.Lookup(name)
+	if !ok {
+		return nil, fmt.Errorf("unsupported format: %s (supported: %s)", name, strings.Join(r.Names(), ", "))
+	}
+
+	return format.New(settings), nil
+}
+
+// Formats returns the registered formats sorted by name.
+func (r *Registry) Formats() []Format {
+	formats := make([]Format, len(r.formats))
+	copy(formats, r.formats)
+	sort.Slice(formats, func(i, j int) bool { return formats[i].Name < formats[j].Name })
+
+	return formats
+}
+
+// Names returns the names of the registered formats, sorted.
+func (r *Registry) Names() []string {
+	formats := r.Formats()
+
+	names := make([]string, len(formats))
+	for i, format := range formats {
+		names[i] = format.Name
+	}
+
+	return names
+}
+
+func builtinFormats() []Format {
+	return []Format{
+		{
+			Name:        pdfFormat,
+			Extension:   ".pdf",
+			MIMEType:    "application/pdf",
+			Description: "PDF document",
+			New: func(s Settings) domain.Converter {
+				opts := []PDFOption{WithPDFGeneratedExamples(s.GenerateExamples)}
+				if s.PageSize != "" {
+					opts = append(opts, WithPageSize(s.PageSize))
+				}
+				if s.Orientation != "" {
+					opts = append(opts, WithOrientation(s.Orientation))
+				}
+				if s.Margins != nil {
+					opts = append(opts, WithMargins(*s.Margins))
+				}
+
+				return NewPDFConverter(opts...)
+			},
+		},
+		{
+			Name:        docxFormat,
+			Aliases:     []string{"word"},
+			Extension:   ".docx",
+			MIMEType:    "application/vnd.openxmlformats-officedocument.wordprocessingml.document",
+			Description: "Word document",
+			New: func(s Settings) domain.Converter {
+				opts := []DocxOption{WithDocxGeneratedExamples(s.GenerateExamples)}
+				if s.ReferenceDoc != "" {
+					opts = append(opts, WithReferenceDoc(s.ReferenceDoc))
+				}
+
+				return NewDocxConverter(opts...)
+			},
+		},
+		{
+			Name:        odtFormat,
+			Extension:   ".odt",
+			MIMEType:    odtMimeType,
+			Description: "OpenDocument text document",
+			New: func(s Settings) domain.Converter {
+				return NewODTConverter(WithODTGeneratedExamples(s.GenerateExamples))
+			},
+		},
+		{
+			Name:        asciidocFormat,
+			Aliases:     []string{"adoc"},
+			Extension:   ".adoc",
+			MIMEType:    "text/asciidoc",
+			Description: "AsciiDoc source",
+			New: func(s Settings) domain.Converter {
+				return NewAsciiDocConverter(WithAsciiDocGeneratedExamples(s.GenerateExamples))
+			},
+		},
+		{
+			Name:        adfFormat,
+			Aliases:     []string{"adf"},
+			Extension:   ".adf.json", // Not .json, which would claim every JSON output file
+			MIMEType:    "application/json",
+			Description: "Confluence Cloud page in Atlassian Document Format",
+			New: func(s Settings) domain.Converter {
+				return NewADFConverter(WithADFGeneratedExamples(s.GenerateExamples))
+			},
+		},
+		{
+			Name:        storageFormat,
+			Aliases:     []string{"storage"},
+			Extension:   ".xhtml",
+			MIMEType:    "application/xhtml+xml",
+			Description: "Confluence Server/Data Center page in storage format",
+			New: func(s Settings) domain.Converter {
+				return NewConfluenceStorageConverter(WithConfluenceStorageGeneratedExamples(s.GenerateExamples))
+			},
+		},
+		{
+			Name:        TemplateFormat,
+			Description: "Output of user-supplied Go templates",
+			New: func(s Settings) domain.Converter {
+				return NewTemplateConverter(WithTemplateFiles(s.TemplateFiles...), WithTemplateEntry(s.TemplateEntry))
+			},
+		},
+	}
+}
@@ -0,0 +1,33 @@
+package converters_test
+
+import (
+	"testing"
+
+	"github.com/GabrielNunesIT/openapi-converter/internal/adapters/converters"
+)
+
+func TestRegistryForFile(t *testing.T) {
+	tests := []struct {
+		fileName string
+		want     string // Format name, or empty when none is inferred
+	}{
+		{fileName: "api.pdf", want: "pdf"},
+		{fileName: "out/API.DOCX", want: "docx"},
+		{fileName: "api.adf.json", want: "confluence"},
+		{fileName: "api.json"},
+		{fileName: "api.v1.xhtml", want: "confluence-storage"},
+		{fileName: "api"},
+		{fileName: ".pdf"},
+	}
+
+	registry := converters.DefaultRegistry()
+
+	for _, tt := range tests {
+		t.Run(tt.fileName, func(t *testing.T) {
+			format, ok := registry.ForFile(tt.fileName)
+			if ok != (tt.want != "") || format.Name != tt.want {
+				t.Errorf("ForFile(%q) = %q, %v, want %q", tt.fileName, format.Name, ok, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,198 @@
+package converters
+
+import (
+	"math"
+	"strings"
+
+	"github.com/GabrielNunesIT/openapi-converter/internal/domain"
+)
+
+// exampleMaxDepth bounds how deep nested objects and arrays are expanded when
+// synthesizing examples, so recursive schemas terminate.
+const exampleMaxDepth = 5
+
+// Bounds on the size of synthesized arrays and strings, which otherwise
+// follow minItems and minLength however large they are.
+const (
+	exampleMaxItems  = 3
+	exampleMaxLength = 64
+)
+
+// Sample values for string formats.
+var formatExamples = map[string]string{
+	"date":      "2024-01-15",
+	"date-time": "2024-01-15T09:30:00Z",
+	"time":      "09:30:00",
+	"email":     "user@example.com",
+	"uuid":      "3fa85f64-5717-4562-b3fc-2c963f66afa6",
+	"uri":       "https://example.com/resource",
+	"url":       "https://example.com/resource",
+	"hostname":  "example.com",
+	"ipv4":      "192.0.2.1",
+	"ipv6":      "2001:db8::1",
+	"byte":      "ZXhhbXBsZQ==",
+	"binary":    "<binary>",
+	"password":  "********",
+}
+
+// exampleSynthesizer builds example payloads from schemas for media types that
+// have none. Referenced schemas are resolved against the document components.
+type exampleSynthesizer struct {
+	components map[string]domain.Schema
+}
+
+func newExampleSynthesizer(components map[string]domain.Schema) *exampleSynthesizer {
+	return &exampleSynthesizer{components: components}
+}
+
+// synthesize returns an example value for a schema, or nil when the schema
+// carries no usable type information.
+func (s *exampleSynthesizer) synthesize(schema domain.Schema) interface{} {
+	return s.value(schema, 0, make(map[string]bool))
+}
+
+func (s *exampleSynthesizer) value(schema domain.Schema, depth int, active map[string]bool) interface{} {
+	if schema.Example != nil {
+		return schema.Example
+	}
+	if schema.Default != nil {
+		return schema.Default
+	}
+	if len(schema.Enum) > 0 {
+		return schema.Enum[0]
+	}
+
+	if schema.Ref != "" {
+		// Stop at references that are already being expanded
+		if active[schema.Ref] {
+			return nil
+		}
+		active[schema.Ref] = true
+		defer delete(active, schema.Ref)
+
+		if schema.Type == "" && len(schema.Properties) == 0 && schema.Items == nil {
+			if resolved, ok := s.components[extractRefName(schema.Ref)]; ok {
+				return s.value(resolved, depth, active)
+			}
+		}
+	}
+
+	switch {
+	case schema.Type == "object" || (schema.Type == "" && len(schema.Properties) > 0):
+		if depth >= exampleMaxDepth {
+			return nil
+		}
+
+		obj := make(map[string]interface{}, len(schema.Properties))
+		for name, prop := range schema.Properties {
+			if v := s.value(prop, depth+1, active); v != nil {
+				obj[name] = v
+			}
+		}
+
+		return obj
+
+	case schema.Type == "array" || (schema.Type == "" && schema.Items != nil):
+		if depth >= exampleMaxDepth || schema.Items == nil {
+			return []interface{}{}
+		}
+
+		item := s.value(*schema.Items, depth+1, active)
+		if item == nil {
+			return []interface{}{}
+		}
+
+		count := min(max(schema.MinItems, 1), exampleMaxItems)
+		if schema.MaxItems != nil {
+			count = min(count, *schema.MaxItems)
+		}
+
+		items := make([]interface{}, count)
+		for i := range items {
+			items[i] = item
+		}
+
+		return items
+
+	case schema.Type == "string":
+		return exampleString(schema)
+
+	case schema.Type == "integer":
+		return int64(exampleNumber(schema, 1, true))
+
+	case schema.Type == "number":
+		return exampleNumber(schema, 1.5, false)
+
+	case schema.Type == "boolean":
+		return true
+	}
+
+	return nil
+}
+
+// exampleString picks a sample for the string format and fits it to the length bounds.
+func exampleString(schema domain.Schema) string {
+	str, ok := formatExamples[schema.Format]
+	if !ok {
+		str = "string"
+	}
+
+	if n := min(schema.MinLength, exampleMaxLength); uint64(len(str)) < n {
+		str += strings.Repeat("x", int(n)-len(str))
+	}
+	if schema.MaxLength != nil && uint64(len(str)) > *schema.MaxLength {
+		str = str[:*schema.MaxLength]
+	}
+
+	return str
+}
+
+// exampleNumber moves a fallback value inside the schema's minimum and
+// maximum, keeping clear of the bounds that are exclusive.
+func exampleNumber(schema domain.Schema, fallback float64, integer bool) float64 {
+	lower, lowerOpen := math.Inf(-1), false
+	if schema.Minimum != nil {
+		lower, lowerOpen = *schema.Minimum, schema.ExclusiveMinimum
+	}
+
+	upper, upperOpen := math.Inf(1), false
+	if schema.Maximum != nil {
+		upper, upperOpen = *schema.Maximum, schema.ExclusiveMaximum
+	}
+
+	if integer {
+		// The closest integers within the bounds
+		if lowerOpen && lower == math.Floor(lower) {
+			lower++
+		}
+		if upperOpen && upper == math.Ceil(upper) {
+			upper--
+		}
+		lower, upper = math.Ceil(lower), math.Floor(upper)
+		lowerOpen, upperOpen = false, false
+	}
+
+	v := fallback
+	if v < lower || (lowerOpen && v == lower) {
+		v = lower
+		if lowerOpen {
+			v++
+		}
+	}
+	if v > upper || (upperOpen && v == upper) {
+		v = upper
+		if upperOpen {
+			v--
+		}
+	}
+
+	// A range narrower than the steps above: take its middle
+	if v < lower || (lowerOpen && v == lower) {
+		v = (lower + upper) / 2
+		if integer {
+			v = math.Ceil(v)
+		}
+	}
+
+	return v
+}
@@ -0,0 +1,138 @@
+package converters
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/GabrielNunesIT/openapi-converter/internal/domain"
+)
+
+func TestExampleNumber(t *testing.T) {
+	bound := func(v float64) *float64 {
+		return &v
+	}
+
+	tests := []struct {
+		name     string
+		schema   domain.Schema
+		fallback float64
+		integer  bool
+		want     float64
+	}{
+		{name: "unbounded", fallback: 1.5, want: 1.5},
+		{
+			name:     "fallback within bounds",
+			schema:   domain.Schema{Minimum: bound(0), Maximum: bound(10)},
+			fallback: 1.5,
+			want:     1.5,
+		},
+		{name: "raised to minimum", schema: domain.Schema{Minimum: bound(5)}, fallback: 1.5, want: 5},
+		{name: "lowered to maximum", schema: domain.Schema{Maximum: bound(-2)}, fallback: 1.5, want: -2},
+		{
+			name:     "exclusive minimum",
+			schema:   domain.Schema{Minimum: bound(1.5), ExclusiveMinimum: true},
+			fallback: 1.5,
+			want:     2.5,
+		},
+		{
+			name:     "exclusive maximum",
+			schema:   domain.Schema{Maximum: bound(0), ExclusiveMaximum: true},
+			fallback: 1.5,
+			want:     -1,
+		},
+		{
+			name:     "exclusive range narrower than a step",
+			schema:   domain.Schema{Minimum: bound(0), Maximum: bound(0.5), ExclusiveMinimum: true, ExclusiveMaximum: true},
+			fallback: 1.5,
+			want:     0.25,
+		},
+		{name: "integer", fallback: 1, integer: true, want: 1},
+		{
+			name:     "integer raised to fractional minimum",
+			schema:   domain.Schema{Minimum: bound(2.2)},
+			fallback: 1,
+			integer:  true,
+			want:     3,
+		},
+		{
+			name:     "integer lowered to fractional maximum",
+			schema:   domain.Schema{Maximum: bound(-0.5)},
+			fallback: 1,
+			integer:  true,
+			want:     -1,
+		},
+		{
+			name:     "integer exclusive minimum",
+			schema:   domain.Schema{Minimum: bound(1), ExclusiveMinimum: true},
+			fallback: 1,
+			integer:  true,
+			want:     2,
+		},
+		{
+			name:     "integer exclusive fractional maximum",
+			schema:   domain.Schema{Maximum: bound(0.5), ExclusiveMaximum: true},
+			fallback: 1,
+			integer:  true,
+			want:     0,
+		},
+		{
+			name: "integer exclusive range holding one integer",
+			schema: domain.Schema{
+				Minimum: bound(4), Maximum: bound(6), ExclusiveMinimum: true, ExclusiveMaximum: true,
+			},
+			fallback: 1,
+			integer:  true,
+			want:     5,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := exampleNumber(tt.schema, tt.fallback, tt.integer); got != tt.want {
+				t.Errorf("exampleNumber() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExampleString(t *testing.T) {
+	length := func(n uint64) *uint64 {
+		return &n
+	}
+
+	tests := []struct {
+		name   string
+		schema domain.Schema
+		want   string
+	}{
+		{name: "plain", want: "string"},
+		{name: "format", schema: domain.Schema{Format: "date"}, want: "2024-01-15"},
+		{name: "unknown format", schema: domain.Schema{Format: "color"}, want: "string"},
+		{name: "padded to minLength", schema: domain.Schema{MinLength: 8}, want: "stringxx"},
+		{
+			name:   "minLength capped",
+			schema: domain.Schema{MinLength: 1000},
+			want:   "string" + strings.Repeat("x", exampleMaxLength-len("string")),
+		},
+		{name: "cut to maxLength", schema: domain.Schema{MaxLength: length(3)}, want: "str"},
+		{
+			name:   "maxLength below the format sample",
+			schema: domain.Schema{Format: "date-time", MaxLength: length(10)},
+			want:   "2024-01-15",
+		},
+		{name: "maxLength zero", schema: domain.Schema{Format: "uuid", MaxLength: length(0)}, want: ""},
+		{
+			name:   "maxLength below minLength",
+			schema: domain.Schema{MinLength: 10, MaxLength: length(4)},
+			want:   "stri",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := exampleString(tt.schema); got != tt.want {
+				t.Errorf("exampleString() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
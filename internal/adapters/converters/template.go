@@ -0,0 +1,267 @@
+package converters
+
+import (
+	"encoding/json"
+	"fmt"
+	htmltemplate "html/template"
+	"io"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/GabrielNunesIT/openapi-converter/internal/domain"
+)
+
+// TemplateFormat is the name of the template format.
+const TemplateFormat = "template"
+
+// TemplateConverter renders OpenAPI documents through user-supplied Go
+// templates. All template files are parsed into one set, so files can define
+// partials used by the others; the entry template is executed with the
+// document as its data.
+type TemplateConverter struct {
+	patterns []string
+	entry    string
+	html     bool
+}
+
+// TemplateOption configures the template converter.
+type TemplateOption func(*TemplateConverter)
+
+// WithTemplateFiles adds template files, given as paths or glob patterns.
+func WithTemplateFiles(patterns ...string) TemplateOption {
+	return func(c *TemplateConverter) {
+		c.patterns = append(c.patterns, patterns...)
+	}
+}
+
+// WithTemplateEntry sets the name of the template to execute. It defaults
+// to the base name of the first template file.
+func WithTemplateEntry(name string) TemplateOption {
+	return func(c *TemplateConverter) {
+		c.entry = name
+	}
+}
+
+// WithHTMLTemplates parses the templates with html/template, which escapes
+// values for the context they appear in. Templates whose entry file has an
+// .html or .htm extension always use html/template.
+func WithHTMLTemplates(enabled bool) TemplateOption {
+	return func(c *TemplateConverter) {
+		c.html = enabled
+	}
+}
+
+// NewTemplateConverter creates a new template converter.
+func NewTemplateConverter(opts ...TemplateOption) *TemplateConverter {
+	c := &TemplateConverter{}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+// Format returns the format name.
+func (c *TemplateConverter) Format() string {
+	return TemplateFormat
+}
+
+// Extension returns the extension of the files the entry template writes,
+// taken from its name without a .tmpl, .tpl or .gotmpl suffix, as in
+// "index.html.tmpl".
+func (c *TemplateConverter) Extension() string {
+	if len(c.patterns) == 0 {
+		return ""
+	}
+
+	name := c.entry
+	if name == "" {
+		files, err := c.files()
+		if err != nil || len(files) == 0 {
+			return ""
+		}
+		name = filepath.Base(files[0])
+	}
+
+	return filepath.Ext(trimTemplateSuffix(name))
+}
+
+// Convert renders the document through the entry template.
+func (c *TemplateConverter) Convert(doc *domain.OpenAPIDocument, w io.Writer) error {
+	files, err := c.files()
+	if err != nil {
+		return err
+	}
+
+	entry := c.entry
+	if entry == "" {
+		entry = filepath.Base(files[0])
+	}
+
+	var set interface {
+		ExecuteTemplate(w io.Writer, name string, data any) error
+	}
+
+	if c.html || isHTMLTemplate(entry) {
+		set, err = htmltemplate.New(entry).Funcs(htmltemplate.FuncMap(templateFuncs())).ParseFiles(files...)
+	} else {
+		set, err = template.New(entry).Funcs(templateFuncs()).ParseFiles(files...)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to parse templates: %w", err)
+	}
+
+	if err := set.ExecuteTemplate(w, entry, doc); err != nil {
+		return fmt.Errorf("failed to execute template %s: %w", entry, err)
+	}
+
+	return nil
+}
+
+// files expands the template patterns into file names, in the order given.
+func (c *TemplateConverter) files() ([]string, error) {
+	if len(c.patterns) == 0 {
+		return nil, fmt.Errorf("no template files given")
+	}
+
+	var files []string
+	seen := make(map[string]bool)
+
+	for _, pattern := range c.patterns {
+		matches, err := filepath.Glob(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid template pattern %q: %w", pattern, err)
+		}
+		if len(matches) == 0 {
+			return nil, fmt.Errorf("template pattern %q matches no files", pattern)
+		}
+
+		for _, match := range matches {
+			if !seen[match] {
+				seen[match] = true
+				files = append(files, match)
+			}
+		}
+	}
+
+	return files, nil
+}
+
+func trimTemplateSuffix(name string) string {
+	for _, suffix := range []string{".tmpl", ".tpl", ".gotmpl"} {
+		if strings.HasSuffix(name, suffix) {
+			return strings.TrimSuffix(name, suffix)
+		}
+	}
+
+	return name
+}
+
+func isHTMLTemplate(name string) bool {
+	ext := strings.ToLower(filepath.Ext(trimTemplateSuffix(name)))
+
+	return ext == ".html" || ext == ".htm"
+}
+
+// TemplateTag is a tag with its endpoints and the names of the component
+// schemas they use, as returned by the groupByTag template function.
+type TemplateTag struct {
+	Name        string
+	Description string
+	Endpoints   []TemplateEndpoint
+	Components  []string
+}
+
+// TemplateEndpoint is an operation together with its path.
+type TemplateEndpoint struct {
+	Path string
+	domain.Operation
+}
+
+var templateAnchorPattern = regexp.MustCompile(`[^a-z0-9]+`)
+
+// templateFuncs returns the functions available to templates.
+func templateFuncs() template.FuncMap {
+	return template.FuncMap{
+		"sortedKeys":     templateSortedKeys,
+		"refName":        extractRefName,
+		"schemaType":     templateSchemaType,
+		"groupByTag":     templateGroupByTag,
+		"markdownToText": markdownToText,
+		"toJSON":         templateJSON,
+		"anchor": func(s string) string {
+			return strings.Trim(templateAnchorPattern.ReplaceAllString(strings.ToLower(s), "-"), "-")
+		},
+		"upper":     strings.ToUpper,
+		"lower":     strings.ToLower,
+		"trim":      strings.TrimSpace,
+		"join":      strings.Join,
+		"replace":   strings.ReplaceAll,
+		"hasPrefix": strings.HasPrefix,
+		"indent": func(spaces int, s string) string {
+			pad := strings.Repeat(" ", spaces)
+			return pad + strings.ReplaceAll(s, "\n", "\n"+pad)
+		},
+	}
+}
+
+// templateSortedKeys returns the keys of a string-keyed map in ascending
+// order, so templates can range over maps such as Components
+// deterministically.
+func templateSortedKeys(m any) ([]string, error) {
+	v := reflect.ValueOf(m)
+	if v.Kind() != reflect.Map || v.Type().Key().Kind() != reflect.String {
+		return nil, fmt.Errorf("sortedKeys: expected a map with string keys, got %T", m)
+	}
+
+	keys := make([]string, 0, v.Len())
+	for _, key := range v.MapKeys() {
+		keys = append(keys, key.String())
+	}
+	sort.Strings(keys)
+
+	return keys, nil
+}
+
+// templateSchemaType returns the display name of a schema, such as "[]Pet".
+func templateSchemaType(schema domain.Schema) string {
+	name, _ := schemaTypeName(schema)
+
+	return name
+}
+
+// templateGroupByTag groups the operations of a document by tag, in the
+// order the other converters render them.
+func templateGroupByTag(doc *domain.OpenAPIDocument) []TemplateTag {
+	layout := newDocumentLayout(doc)
+
+	groups := make([]TemplateTag, 0, len(layout.tags))
+	for _, tag := range layout.tags {
+		group := TemplateTag{Name: tag.name, Description: tag.description}
+
+		for _, ep := range tag.endpoints {
+			group.Endpoints = append(group.Endpoints, TemplateEndpoint{Path: ep.path, Operation: ep.operation})
+		}
+		for _, component := range tag.components {
+			group.Components = append(group.Components, component.name)
+		}
+
+		groups = append(groups, group)
+	}
+
+	return groups
+}
+
+// templateJSON formats a value as indented JSON.
+func templateJSON(v any) (string, error) {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("toJSON: %w", err)
+	}
+
+	return string(data), nil
+}
@@ -0,0 +1,143 @@
+package converters_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/GabrielNunesIT/openapi-converter/internal/adapters/converters"
+	"github.com/GabrielNunesIT/openapi-converter/internal/domain"
+)
+
+// writeTemplates writes template files into a temporary directory and
+// returns it.
+func writeTemplates(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return dir
+}
+
+func TestTemplateConvert(t *testing.T) {
+	doc := &domain.OpenAPIDocument{
+		Title:       "Pets",
+		Version:     "1.0.0",
+		Description: "<b>pets</b>",
+		Paths: []domain.Path{
+			{Path: "/pets", Operations: []domain.Operation{{Method: "get", Tags: []string{"pets"}, Summary: "List"}}},
+			{Path: "/orders", Operations: []domain.Operation{{Method: "post", Tags: []string{"store"}, Summary: "Order"}}},
+		},
+	}
+
+	files := map[string]string{
+		"title.md.tmpl":    "# {{.Title}} {{.Version}}",
+		"index.md.tmpl":    `{{range groupByTag .}}{{template "tag" .}}{{end}}`,
+		"partials/tag.tpl": `{{define "tag"}}[{{.Name}}{{range .Endpoints}} {{upper .Method}} {{.Path}}{{end}}]{{end}}`,
+		"desc.md.tmpl":     "{{.Description}}",
+		"desc.html.tmpl":   "<p>{{.Description}}</p>",
+		"broken.tmpl":      "{{.Title",
+	}
+
+	tests := []struct {
+		name     string
+		patterns []string
+		entry    string
+		html     bool
+		want     string
+		wantErr  string
+	}{
+		{name: "entry defaults to the first file", patterns: []string{"title.md.tmpl", "index.md.tmpl"},
+			want: "# Pets 1.0.0"},
+		{name: "entry", patterns: []string{"title.md.tmpl", "index.md.tmpl", "partials/tag.tpl"},
+			entry: "index.md.tmpl", want: "[pets GET /pets][store POST /orders]"},
+		{name: "partials", patterns: []string{"index.md.tmpl", "partials/*.tpl"},
+			want: "[pets GET /pets][store POST /orders]"},
+		{name: "glob", patterns: []string{"*.md.tmpl", "partials/*"}, entry: "title.md.tmpl", want: "# Pets 1.0.0"},
+		{name: "text is not escaped", patterns: []string{"desc.md.tmpl"}, want: "<b>pets</b>"},
+		{name: "HTML entry is escaped", patterns: []string{"desc.html.tmpl"}, want: "<p>&lt;b&gt;pets&lt;/b&gt;</p>"},
+		{name: "HTML option", patterns: []string{"desc.md.tmpl"}, html: true, want: "&lt;b&gt;pets&lt;/b&gt;"},
+		{name: "missing entry", patterns: []string{"title.md.tmpl"}, entry: "missing.tmpl",
+			wantErr: "failed to execute template missing.tmpl"},
+		{name: "missing partial", patterns: []string{"index.md.tmpl"},
+			wantErr: `template "tag" not defined`},
+		{name: "no files", wantErr: "no template files given"},
+		{name: "pattern without matches", patterns: []string{"*.gotmpl"}, wantErr: "matches no files"},
+		{name: "invalid pattern", patterns: []string{"[.tmpl"}, wantErr: "invalid template pattern"},
+		{name: "parse error", patterns: []string{"broken.tmpl"}, wantErr: "failed to parse templates"},
+	}
+
+	dir := writeTemplates(t, files)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			patterns := make([]string, len(tt.patterns))
+			for i, pattern := range tt.patterns {
+				patterns[i] = filepath.Join(dir, pattern)
+			}
+
+			c := converters.NewTemplateConverter(
+				converters.WithTemplateFiles(patterns...),
+				converters.WithTemplateEntry(tt.entry),
+				converters.WithHTMLTemplates(tt.html),
+			)
+
+			var buf bytes.Buffer
+			err := c.Convert(doc, &buf)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("Convert() error = %v, want it to contain %q", err, tt.wantErr)
+				}
+
+				return
+			}
+			if err != nil {
+				t.Fatalf("Convert() error = %v", err)
+			}
+
+			if got := buf.String(); got != tt.want {
+				t.Errorf("Convert() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTemplateExtension(t *testing.T) {
+	dir := writeTemplates(t, map[string]string{"index.html.tmpl": "", "notes.txt": ""})
+
+	tests := []struct {
+		name     string
+		patterns []string
+		entry    string
+		want     string
+	}{
+		{name: "no files"},
+		{name: "first file", patterns: []string{filepath.Join(dir, "*.tmpl")}, want: ".html"},
+		{name: "entry", patterns: []string{filepath.Join(dir, "*")}, entry: "report.adoc.gotmpl", want: ".adoc"},
+		{name: "no template suffix", patterns: []string{filepath.Join(dir, "notes.txt")}, want: ".txt"},
+		{name: "no matches", patterns: []string{filepath.Join(dir, "*.tpl")}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := converters.NewTemplateConverter(
+				converters.WithTemplateFiles(tt.patterns...),
+				converters.WithTemplateEntry(tt.entry),
+			)
+			if got := c.Extension(); got != tt.want {
+				t.Errorf("Extension() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,394 @@
+package loaders
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/GabrielNunesIT/openapi-converter/internal/domain"
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// specMapper converts a parsed specification into a domain document,
+// collecting warnings for what the document cannot represent.
+type specMapper struct {
+	warnings []domain.Warning
+	warned   map[string]bool // Warnings already reported, by subject and message
+
+	// References being expanded, so recursive schemas stop at themselves, and
+	// where the innermost of them was entered
+	expanding map[string]bool
+	scopes    []schemaScope
+}
+
+// schemaScope is a reference being expanded and the location it was reached at.
+type schemaScope struct {
+	ref      string
+	location string
+}
+
+func newSpecMapper() *specMapper {
+	return &specMapper{
+		warned:    make(map[string]bool),
+		expanding: make(map[string]bool),
+	}
+}
+
+// warn records a warning once per subject, which is the component reference
+// when the construct belongs to one, or else the location.
+func (m *specMapper) warn(subject, location, format string, args ...any) {
+	message := fmt.Sprintf(format, args...)
+
+	key := subject + "\x00" + message
+	if m.warned[key] {
+		return
+	}
+	m.warned[key] = true
+
+	m.warnings = append(m.warnings, domain.Warning{Location: location, Message: message})
+}
+
+// sortedWarnings returns the warnings ordered by location and message.
+func (m *specMapper) sortedWarnings() []domain.Warning {
+	sort.Slice(m.warnings, func(i, j int) bool {
+		if m.warnings[i].Location == m.warnings[j].Location {
+			return m.warnings[i].Message < m.warnings[j].Message
+		}
+
+		return m.warnings[i].Location < m.warnings[j].Location
+	})
+
+	return m.warnings
+}
+
+func (m *specMapper) convertSpec(spec *openapi3.T) *domain.OpenAPIDocument {
+	doc := &domain.OpenAPIDocument{
+		Title:           spec.Info.Title,
+		Version:         spec.Info.Version,
+		Description:     spec.Info.Description,
+		Components:      make(map[string]domain.Schema),
+		SecuritySchemes: make(map[string]domain.SecurityScheme),
+	}
+
+	// Convert security schemes
+	if spec.Components != nil && spec.Components.SecuritySchemes != nil {
+		for name, ref := range spec.Components.SecuritySchemes {
+			if ref.Value != nil {
+				doc.SecuritySchemes[name] = domain.SecurityScheme{
+					Type:        ref.Value.Type,
+					Name:        ref.Value.Name,
+					Description: ref.Value.Description,
+					In:          ref.Value.In,
+					Scheme:      ref.Value.Scheme,
+				}
+
+				if ref.Value.Flows != nil {
+					m.warn("", "components.securitySchemes."+name, "OAuth flows are not rendered")
+				}
+			}
+		}
+	}
+
+	// Convert global security
+	for _, securityReq := range spec.Security {
+		sec := make(map[string][]string)
+		for name, scopes := range securityReq {
+			sec[name] = scopes
+		}
+		doc.Security = append(doc.Security, sec)
+	}
+
+	// Convert servers
+	for _, server := range spec.Servers {
+		doc.Servers = append(doc.Servers, domain.Server{
+			URL:         server.URL,
+			Description: server.Description,
+		})
+
+		if len(server.Variables) > 0 {
+			m.warn("", "servers", "Variables of server %s are not rendered", server.URL)
+		}
+	}
+
+	// Convert tags
+	for _, tag := range spec.Tags {
+		if tag != nil {
+			doc.Tags = append(doc.Tags, domain.Tag{
+				Name:        tag.Name,
+				Description: tag.Description,
+			})
+		}
+	}
+
+	// Convert paths. Maps are walked in key order so that a construct used in
+	// several places is always reported at the same one.
+	paths := spec.Paths.Map()
+	for _, pathStr := range slices.Sorted(maps.Keys(paths)) {
+		path := domain.Path{Path: pathStr}
+
+		path.Operations = m.convertOperations(pathStr, paths[pathStr])
+		doc.Paths = append(doc.Paths, path)
+	}
+
+	// Convert components/schemas
+	if spec.Components != nil && spec.Components.Schemas != nil {
+		for _, name := range slices.Sorted(maps.Keys(spec.Components.Schemas)) {
+			doc.Components[name] = m.convertComponent(name, spec.Components.Schemas[name])
+		}
+	}
+
+	return doc
+}
+
+func (m *specMapper) convertOperations(pathStr string, pathItem *openapi3.PathItem) []domain.Operation {
+	var operations []domain.Operation
+
+	ops := pathItem.Operations()
+	for _, method := range slices.Sorted(maps.Keys(ops)) {
+		op := ops[method]
+		location := method + " " + pathStr
+
+		operation := domain.Operation{
+			Method:      method,
+			Summary:     op.Summary,
+			Description: op.Description,
+			OperationID: op.OperationID,
+			Tags:        op.Tags,
+		}
+
+		operation.Parameters = m.convertParameters(location, pathItem.Parameters, op.Parameters)
+
+		// Convert responses
+		if op.Responses != nil {
+			responses := op.Responses.Map()
+			for _, statusCode := range slices.Sorted(maps.Keys(responses)) {
+				response := responses[statusCode]
+				if response.Value == nil {
+					continue
+				}
+
+				resp := domain.Response{
+					StatusCode: statusCode,
+				}
+
+				if response.Value.Description != nil {
+					resp.Description = *response.Value.Description
+				}
+
+				respLocation := fmt.Sprintf("%s response %s", location, statusCode)
+				if len(response.Value.Headers) > 0 {
+					m.warn("", respLocation, "Response headers are not rendered")
+				}
+				if len(response.Value.Links) > 0 {
+					m.warn("", respLocation, "Response links are not rendered")
+				}
+
+				resp.Content = m.convertContent(response.Value.Content, respLocation)
+				operation.Responses = append(operation.Responses, resp)
+			}
+		}
+
+		// Convert request body
+		if op.RequestBody != nil && op.RequestBody.Value != nil {
+			operation.RequestBody = &domain.RequestBody{
+				Description: op.RequestBody.Value.Description,
+				Required:    op.RequestBody.Value.Required,
+				Content:     m.convertContent(op.RequestBody.Value.Content, location+" request body"),
+			}
+		}
+
+		if len(op.Callbacks) > 0 {
+			m.warn("", location, "Callbacks are not rendered")
+		}
+		if op.Security != nil {
+			m.warn("", location, "Operation security requirements are not rendered; the document-wide requirements are shown")
+		}
+
+		operations = append(operations, operation)
+	}
+
+	return operations
+}
+
+// convertParameters merges the parameters of a path with those of one of its
+// operations, which override path parameters of the same name and location.
+func (m *specMapper) convertParameters(location string, pathParams, opParams openapi3.Parameters) []domain.Parameter {
+	var params []domain.Parameter
+	index := make(map[string]int)
+
+	for _, param := range append(append(openapi3.Parameters{}, pathParams...), opParams...) {
+		if param == nil || param.Value == nil {
+			continue
+		}
+
+		converted := domain.Parameter{
+			Name:        param.Value.Name,
+			In:          param.Value.In,
+			Description: param.Value.Description,
+			Required:    param.Value.Required,
+			Schema:      m.convertSchema(param.Value.Schema, fmt.Sprintf("%s parameter %s", location, param.Value.Name)),
+		}
+
+		key := converted.In + ":" + converted.Name
+		if i, ok := index[key]; ok {
+			params[i] = converted
+			continue
+		}
+
+		index[key] = len(params)
+		params = append(params, converted)
+	}
+
+	return params
+}
+
+func (m *specMapper) convertContent(content openapi3.Content, location string) map[string]domain.MediaType {
+	result := make(map[string]domain.MediaType)
+
+	for _, mediaType := range slices.Sorted(maps.Keys(content)) {
+		item := content[mediaType]
+		mt := domain.MediaType{
+			Schema:  m.convertSchema(item.Schema, location+" "+mediaType),
+			Example: item.Example,
+		}
+
+		if len(item.Examples) > 0 {
+			mt.Examples = make(map[string]interface{})
+			for name, ex := range item.Examples {
+				if ex.Value != nil {
+					mt.Examples[name] = ex.Value.Value
+				}
+			}
+		}
+
+		result[mediaType] = mt
+	}
+
+	return result
+}
+
+// convertComponent converts a component schema, which counts as being
+// expanded under its own reference.
+func (m *specMapper) convertComponent(name string, ref *openapi3.SchemaRef) domain.Schema {
+	if ref != nil && ref.Ref == "" {
+		ref = &openapi3.SchemaRef{Ref: "#/components/schemas/" + name, Value: ref.Value}
+		schema := m.convertSchema(ref, "components.schemas."+name)
+		schema.Ref = ""
+
+		return schema
+	}
+
+	return m.convertSchema(ref, "components.schemas."+name)
+}
+
+// convertSchema converts a schema and the schemas nested in it. A reference
+// to a component that is already being expanded is kept as a reference, so
+// recursive schemas end where they repeat.
+func (m *specMapper) convertSchema(ref *openapi3.SchemaRef, location string) domain.Schema {
+	if ref == nil {
+		return domain.Schema{}
+	}
+
+	schema := domain.Schema{
+		Ref: ref.Ref,
+	}
+
+	if ref.Value == nil {
+		return schema
+	}
+
+	if ref.Ref != "" {
+		if m.expanding[ref.Ref] {
+			schema.Type = firstType(ref.Value)
+			schema.Description = ref.Value.Description
+
+			return schema
+		}
+
+		m.expanding[ref.Ref] = true
+		m.scopes = append(m.scopes, schemaScope{ref: ref.Ref, location: location})
+
+		defer func() {
+			delete(m.expanding, ref.Ref)
+			m.scopes = m.scopes[:len(m.scopes)-1]
+		}()
+	}
+
+	m.warnUnsupported(ref.Value, m.subject(location), location)
+
+	schema.Type = firstType(ref.Value)
+	schema.Format = ref.Value.Format
+	schema.Description = ref.Value.Description
+	schema.Required = ref.Value.Required
+	schema.Enum = ref.Value.Enum
+	schema.Default = ref.Value.Default
+	schema.Example = ref.Value.Example
+	schema.Minimum = ref.Value.Min
+	schema.Maximum = ref.Value.Max
+	schema.ExclusiveMinimum = ref.Value.ExclusiveMin
+	schema.ExclusiveMaximum = ref.Value.ExclusiveMax
+	schema.MinLength = ref.Value.MinLength
+	schema.MaxLength = ref.Value.MaxLength
+	schema.MinItems = ref.Value.MinItems
+	schema.MaxItems = ref.Value.MaxItems
+
+	// Convert properties
+	if len(ref.Value.Properties) > 0 {
+		schema.Properties = make(map[string]domain.Schema)
+
+		for _, name := range slices.Sorted(maps.Keys(ref.Value.Properties)) {
+			schema.Properties[name] = m.convertSchema(ref.Value.Properties[name], location+"."+name)
+		}
+	}
+
+	// Convert items for arrays
+	if ref.Value.Items != nil {
+		itemSchema := m.convertSchema(ref.Value.Items, location+"[]")
+		schema.Items = &itemSchema
+	}
+
+	return schema
+}
+
+// subject identifies the schema at a location for deduplicating warnings: the
+// innermost reference being expanded and the path within it, so a component
+// reports its own constructs once however many places it is used from.
+func (m *specMapper) subject(location string) string {
+	if len(m.scopes) == 0 {
+		return location
+	}
+
+	scope := m.scopes[len(m.scopes)-1]
+
+	return scope.ref + strings.TrimPrefix(location, scope.location)
+}
+
+// warnUnsupported reports the schema keywords the domain model leaves out.
+func (m *specMapper) warnUnsupported(value *openapi3.Schema, subject, location string) {
+	for keyword, count := range map[string]int{
+		"allOf": len(value.AllOf),
+		"oneOf": len(value.OneOf),
+		"anyOf": len(value.AnyOf),
+	} {
+		if count > 0 {
+			m.warn(subject, location, "%s is not supported; its schemas are not rendered", keyword)
+		}
+	}
+
+	if value.Not != nil {
+		m.warn(subject, location, "not is not supported and is not rendered")
+	}
+	if value.AdditionalProperties.Schema != nil {
+		m.warn(subject, location, "additionalProperties schemas are not rendered")
+	}
+}
+
+func firstType(value *openapi3.Schema) string {
+	if types := value.Type.Slice(); len(types) > 0 {
+		return types[0]
+	}
+
+	return ""
+}
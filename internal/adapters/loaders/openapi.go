@@ -0,0 +1,249 @@
+// Package loaders reads OpenAPI specifications into domain documents.
+package loaders
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/GabrielNunesIT/openapi-converter/internal/domain"
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// StdinSource is the source name Load reads standard input for.
+const StdinSource = "-"
+
+// OpenAPILoader loads OpenAPI 3.x specifications from files, data, URLs and
+// standard input, following external references.
+type OpenAPILoader struct {
+	httpClient   *http.Client
+	stdin        io.Reader
+	externalRefs bool
+}
+
+// OpenAPILoaderOption configures the OpenAPI loader.
+type OpenAPILoaderOption func(*OpenAPILoader)
+
+// WithHTTPClient sets the client used to fetch specifications and
+// references from URLs. It defaults to http.DefaultClient.
+func WithHTTPClient(client *http.Client) OpenAPILoaderOption {
+	return func(l *OpenAPILoader) {
+		l.httpClient = client
+	}
+}
+
+// WithStdin sets the reader StdinSource reads from. It defaults to os.Stdin.
+func WithStdin(r io.Reader) OpenAPILoaderOption {
+	return func(l *OpenAPILoader) {
+		l.stdin = r
+	}
+}
+
+// WithExternalRefs sets whether references to other files and URLs are
+// followed. It defaults to true; specifications from untrusted sources should
+// be loaded without them, as they can read any file or URL the process can.
+func WithExternalRefs(enabled bool) OpenAPILoaderOption {
+	return func(l *OpenAPILoader) {
+		l.externalRefs = enabled
+	}
+}
+
+// NewOpenAPILoader creates a new OpenAPI loader.
+func NewOpenAPILoader(opts ...OpenAPILoaderOption) *OpenAPILoader {
+	l := &OpenAPILoader{httpClient: http.DefaultClient, stdin: os.Stdin, externalRefs: true}
+	for _, opt := range opts {
+		opt(l)
+	}
+
+	return l
+}
+
+// Load reads the specification at source: a file path, an http(s) URL, or
+// StdinSource for standard input. Relative references in a specification
+// read from standard input resolve against the working directory.
+func (l *OpenAPILoader) Load(ctx context.Context, source string) (*domain.OpenAPIDocument, []domain.Warning, error) {
+	var (
+		spec *openapi3.T
+		err  error
+	)
+
+	switch {
+	case source == StdinSource:
+		var data []byte
+		data, err = io.ReadAll(l.stdin)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read standard input: %w", err)
+		}
+		// A file name in the working directory, for references to resolve against
+		spec, err = l.parseData(ctx, data, "stdin")
+	case isURL(source):
+		spec, err = l.parseURL(ctx, source)
+	default:
+		spec, err = l.parseFile(ctx, source)
+	}
+
+	return loaded(spec, err)
+}
+
+// LoadFile loads a specification from a file. Relative references resolve
+// against the file's directory.
+func (l *OpenAPILoader) LoadFile(path string) (*domain.OpenAPIDocument, []domain.Warning, error) {
+	return loaded(l.parseFile(context.Background(), path))
+}
+
+// LoadData loads a specification from JSON or YAML data. Relative
+// references resolve against location, a file path or URL, which may be
+// empty when the specification has none.
+func (l *OpenAPILoader) LoadData(data []byte, location string) (*domain.OpenAPIDocument, []domain.Warning, error) {
+	return loaded(l.parseData(context.Background(), data, location))
+}
+
+// LoadReader loads a specification read from r.
+func (l *OpenAPILoader) LoadReader(r io.Reader, location string) (*domain.OpenAPIDocument, []domain.Warning, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read OpenAPI specification: %w", err)
+	}
+
+	return l.LoadData(data, location)
+}
+
+// LoadURL loads a specification from an http(s) URL. Relative references
+// resolve against the URL.
+func (l *OpenAPILoader) LoadURL(ctx context.Context, rawURL string) (*domain.OpenAPIDocument, []domain.Warning, error) {
+	return loaded(l.parseURL(ctx, rawURL))
+}
+
+func (l *OpenAPILoader) parseFile(ctx context.Context, path string) (*openapi3.T, error) {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve path: %w", err)
+	}
+
+	spec, err := l.newLoader(ctx).LoadFromFile(absPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse OpenAPI file: %w", err)
+	}
+
+	return spec, nil
+}
+
+func (l *OpenAPILoader) parseData(ctx context.Context, data []byte, location string) (*openapi3.T, error) {
+	loader := l.newLoader(ctx)
+
+	var (
+		spec *openapi3.T
+		err  error
+	)
+
+	if location == "" {
+		spec, err = loader.LoadFromData(data)
+	} else {
+		var base *url.URL
+		base, err = locationURL(location)
+		if err != nil {
+			return nil, err
+		}
+		spec, err = loader.LoadFromDataWithPath(data, base)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse OpenAPI specification: %w", err)
+	}
+
+	return spec, nil
+}
+
+func (l *OpenAPILoader) parseURL(ctx context.Context, rawURL string) (*openapi3.T, error) {
+	location, err := url.Parse(rawURL)
+	if err != nil || location.Host == "" {
+		return nil, fmt.Errorf("invalid URL %q", rawURL)
+	}
+
+	spec, err := l.newLoader(ctx).LoadFromURI(location)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load OpenAPI specification from %s: %w", rawURL, err)
+	}
+
+	return spec, nil
+}
+
+// loaded converts a parsed specification, passing a parse error through.
+func loaded(spec *openapi3.T, err error) (*domain.OpenAPIDocument, []domain.Warning, error) {
+	if err != nil {
+		return nil, nil, err
+	}
+
+	doc, warnings := convert(spec)
+
+	return doc, warnings, nil
+}
+
+// convert maps a parsed specification to a document.
+func convert(spec *openapi3.T) (*domain.OpenAPIDocument, []domain.Warning) {
+	m := newSpecMapper()
+	doc := m.convertSpec(spec)
+
+	return doc, m.sortedWarnings()
+}
+
+func isURL(source string) bool {
+	return strings.HasPrefix(source, "http://") || strings.HasPrefix(source, "https://")
+}
+
+func (l *OpenAPILoader) newLoader(ctx context.Context) *openapi3.Loader {
+	loader := openapi3.NewLoader()
+	loader.Context = ctx
+	loader.IsExternalRefsAllowed = l.externalRefs
+	loader.ReadFromURIFunc = openapi3.URIMapCache(openapi3.ReadFromURIs(l.readFromHTTP, openapi3.ReadFromFile))
+
+	return loader
+}
+
+// readFromHTTP reads http(s) references with the loader's client, honouring
+// the context of the load.
+func (l *OpenAPILoader) readFromHTTP(loader *openapi3.Loader, location *url.URL) ([]byte, error) {
+	if location.Scheme != "http" && location.Scheme != "https" {
+		return nil, openapi3.ErrURINotSupported
+	}
+
+	req, err := http.NewRequestWithContext(loader.Context, http.MethodGet, location.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch %s: %w", location, err)
+	}
+
+	resp, err := l.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch %s: %w", location, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return nil, fmt.Errorf("failed to fetch %s: status %d", location, resp.StatusCode)
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch %s: %w", location, err)
+	}
+
+	return data, nil
+}
+
+// locationURL turns a file path or URL into the base of relative references.
+func locationURL(location string) (*url.URL, error) {
+	if u, err := url.Parse(location); err == nil && u.Host != "" {
+		return u, nil
+	}
+
+	absPath, err := filepath.Abs(location)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve path: %w", err)
+	}
+
+	return &url.URL{Path: filepath.ToSlash(absPath)}, nil
+}
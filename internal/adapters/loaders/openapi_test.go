@@ -0,0 +1,229 @@
+package loaders_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/GabrielNunesIT/openapi-converter/internal/adapters/loaders"
+	"github.com/GabrielNunesIT/openapi-converter/internal/domain"
+)
+
+const selfContained = `openapi: 3.0.3
+info:
+  title: Stdin API
+  version: 2.0.0
+paths:
+  /health:
+    get:
+      responses:
+        "204":
+          description: Healthy
+`
+
+func TestOpenAPILoaderLoad(t *testing.T) {
+	srv := httptest.NewServer(http.FileServer(http.Dir("testdata")))
+	defer srv.Close()
+
+	tests := []struct {
+		name    string
+		source  string
+		stdin   string
+		opts    []loaders.OpenAPILoaderOption
+		title   string
+		paths   []string
+		wantErr string
+	}{
+		{name: "file", source: "testdata/api.yaml", title: "Pets API", paths: []string{"/pets"}},
+		{name: "stdin", source: loaders.StdinSource, stdin: selfContained, title: "Stdin API", paths: []string{"/health"}},
+		{name: "url", source: srv.URL + "/api.yaml", title: "Pets API", paths: []string{"/pets"}},
+		{name: "missing file", source: "testdata/missing.yaml", wantErr: "failed to parse OpenAPI file"},
+		{name: "invalid yaml", source: loaders.StdinSource, stdin: "openapi: [3.0", wantErr: "failed to parse"},
+		{name: "missing url", source: srv.URL + "/missing.yaml", wantErr: "failed to load OpenAPI specification"},
+		{
+			name:    "external references disabled",
+			source:  "testdata/api.yaml",
+			opts:    []loaders.OpenAPILoaderOption{loaders.WithExternalRefs(false)},
+			wantErr: "failed to parse OpenAPI file",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := append([]loaders.OpenAPILoaderOption{loaders.WithStdin(strings.NewReader(tt.stdin))}, tt.opts...)
+
+			doc, _, err := loaders.NewOpenAPILoader(opts...).Load(context.Background(), tt.source)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("Load() error = %v, want it to contain %q", err, tt.wantErr)
+				}
+
+				return
+			}
+			if err != nil {
+				t.Fatalf("Load() error = %v", err)
+			}
+
+			if doc.Title != tt.title {
+				t.Errorf("title = %q, want %q", doc.Title, tt.title)
+			}
+
+			var paths []string
+			for _, path := range doc.Paths {
+				paths = append(paths, path.Path)
+			}
+			if !slices.Equal(paths, tt.paths) {
+				t.Errorf("paths = %v, want %v", paths, tt.paths)
+			}
+		})
+	}
+}
+
+func TestOpenAPILoaderExternalReferences(t *testing.T) {
+	srv := httptest.NewServer(http.FileServer(http.Dir("testdata")))
+	defer srv.Close()
+
+	for _, source := range []string{"testdata/api.yaml", srv.URL + "/api.yaml"} {
+		t.Run(source, func(t *testing.T) {
+			doc, _, err := loaders.NewOpenAPILoader().Load(context.Background(), source)
+			if err != nil {
+				t.Fatalf("Load() error = %v", err)
+			}
+
+			pet := doc.Components["Pet"]
+			if pet.Type != "object" || pet.Properties["name"].Type != "string" {
+				t.Errorf("Pet component = %+v, want the object from schemas.yaml", pet)
+			}
+			if !slices.Equal(pet.Required, []string{"name"}) {
+				t.Errorf("Pet required = %v, want [name]", pet.Required)
+			}
+
+			items := doc.Paths[0].Operations[0].Responses[0].Content["application/json"].Schema.Items
+			if items == nil || items.Properties["tag"].Type != "string" {
+				t.Errorf("response items = %+v, want the referenced Pet", items)
+			}
+		})
+	}
+}
+
+func TestOpenAPILoaderSources(t *testing.T) {
+	data, err := os.ReadFile("testdata/unsupported.yaml")
+	if err != nil {
+		t.Fatalf("failed to read spec: %v", err)
+	}
+
+	srv := httptest.NewServer(http.FileServer(http.Dir("testdata")))
+	defer srv.Close()
+
+	loader := loaders.NewOpenAPILoader()
+
+	want, wantWarnings, err := loader.Load(context.Background(), "testdata/unsupported.yaml")
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	tests := []struct {
+		name string
+		load func() (*domain.OpenAPIDocument, []domain.Warning, error)
+	}{
+		{name: "LoadFile", load: func() (*domain.OpenAPIDocument, []domain.Warning, error) {
+			return loader.LoadFile("testdata/unsupported.yaml")
+		}},
+		{name: "LoadData", load: func() (*domain.OpenAPIDocument, []domain.Warning, error) {
+			return loader.LoadData(data, "")
+		}},
+		{name: "LoadReader", load: func() (*domain.OpenAPIDocument, []domain.Warning, error) {
+			return loader.LoadReader(strings.NewReader(string(data)), "testdata/unsupported.yaml")
+		}},
+		{name: "LoadURL", load: func() (*domain.OpenAPIDocument, []domain.Warning, error) {
+			return loader.LoadURL(context.Background(), srv.URL+"/unsupported.yaml")
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, warnings, err := tt.load()
+			if err != nil {
+				t.Fatalf("%s() error = %v", tt.name, err)
+			}
+
+			if doc.Title != want.Title || len(doc.Paths) != len(want.Paths) {
+				t.Errorf("document = %q with %d paths, want %q with %d", doc.Title, len(doc.Paths), want.Title, len(want.Paths))
+			}
+			if !slices.Equal(warnings, wantWarnings) {
+				t.Errorf("warnings = %v, want %v", warnings, wantWarnings)
+			}
+		})
+	}
+}
+
+func TestOpenAPILoaderWarnings(t *testing.T) {
+	want := []domain.Warning{
+		{
+			Location: "GET /pets",
+			Message:  "Operation security requirements are not rendered; the document-wide requirements are shown",
+		},
+		{Location: "GET /pets response 200", Message: "Response headers are not rendered"},
+		{
+			Location: "GET /pets response 200 application/json",
+			Message:  "oneOf is not supported; its schemas are not rendered",
+		},
+		{Location: "POST /pets", Message: "Callbacks are not rendered"},
+		{Location: "components.schemas.Cat", Message: "additionalProperties schemas are not rendered"},
+		{Location: "components.securitySchemes.oauth", Message: "OAuth flows are not rendered"},
+		{Location: "servers", Message: "Variables of server https://{region}.example.com are not rendered"},
+	}
+
+	// Map order must not change which location a shared component is
+	// reported at
+	for run := range 10 {
+		_, warnings, err := loaders.NewOpenAPILoader().Load(context.Background(), "testdata/unsupported.yaml")
+		if err != nil {
+			t.Fatalf("Load() error = %v", err)
+		}
+
+		if !slices.Equal(warnings, want) {
+			t.Fatalf("run %d: warnings = %v, want %v", run, warnings, want)
+		}
+	}
+}
+
+func TestOpenAPILoaderRecursiveReferences(t *testing.T) {
+	doc, warnings, err := loaders.NewOpenAPILoader().Load(context.Background(), "testdata/recursive.yaml")
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	// Recursion is valid and rendered as a reference, so it is not a warning
+	if len(warnings) != 0 {
+		t.Errorf("warnings = %v, want none", warnings)
+	}
+
+	tests := []struct {
+		name   string
+		schema domain.Schema
+	}{
+		{name: "component", schema: doc.Components["Node"]},
+		{name: "response", schema: doc.Paths[0].Operations[0].Responses[0].Content["application/json"].Schema},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			children := tt.schema.Properties["children"]
+			if children.Items == nil {
+				t.Fatalf("children = %+v, want an array of nodes", children)
+			}
+
+			if children.Items.Ref != "#/components/schemas/Node" || children.Items.Type != "object" {
+				t.Errorf("children items = %+v, want a reference to Node", children.Items)
+			}
+			if children.Items.Properties != nil {
+				t.Errorf("children items are expanded: %+v", children.Items.Properties)
+			}
+		})
+	}
+}
@@ -0,0 +1,199 @@
+// Package plugins runs external converters as output formats.
+//
+// A plugin is an executable named openapi-converter-<format>. For each
+// conversion it is started without arguments, receives a Request as JSON on
+// stdin and writes the converted document to stdout. A non-zero exit code
+// fails the conversion, with stderr as the error message.
+package plugins
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/GabrielNunesIT/openapi-converter/internal/domain"
+)
+
+const (
+	// ProtocolVersion is the version of the Request layout. It changes when
+	// a change would break existing plugins.
+	ProtocolVersion = 1
+
+	// ExecutablePrefix starts the name of plugin executables.
+	ExecutablePrefix = "openapi-converter-"
+
+	// maxStderr bounds how much of a plugin's stderr is kept for errors.
+	maxStderr = 4096
+
+	// waitDelay bounds how long the output of a stopped plugin is waited
+	// for, as processes it started may keep its stdout open.
+	waitDelay = 5 * time.Second
+)
+
+// Request is the JSON message sent to a plugin on stdin.
+type Request struct {
+	ProtocolVersion int                     `json:"protocolVersion"`
+	Format          string                  `json:"format"`
+	Options         Options                 `json:"options"`
+	Document        *domain.OpenAPIDocument `json:"document"`
+}
+
+// Options are the conversion settings passed to a plugin. Plugins ignore the
+// ones they do not support.
+type Options struct {
+	GenerateExamples bool   `json:"generateExamples,omitempty"`
+	PageSize         string `json:"pageSize,omitempty"`
+	Orientation      string `json:"orientation,omitempty"`
+}
+
+// Converter converts documents by running a plugin executable.
+type Converter struct {
+	format  string
+	command string
+	options Options
+}
+
+// NewConverter creates a converter running command for format.
+func NewConverter(format, command string, options Options) *Converter {
+	return &Converter{format: format, command: command, options: options}
+}
+
+// Format returns the format name.
+func (c *Converter) Format() string {
+	return c.format
+}
+
+// Convert sends the document to the plugin and copies its output to w.
+func (c *Converter) Convert(doc *domain.OpenAPIDocument, w io.Writer) error {
+	_, err := c.ConvertContext(context.Background(), doc, w, domain.ConvertOptions{})
+
+	return err
+}
+
+// ConvertContext sends the document to the plugin and copies its output to
+// w, killing the plugin once ctx is done. Plugins report no progress or
+// warnings.
+func (c *Converter) ConvertContext(ctx context.Context, doc *domain.OpenAPIDocument, w io.Writer,
+	_ domain.ConvertOptions,
+) ([]domain.Warning, error) {
+	request, err := json.Marshal(Request{
+		ProtocolVersion: ProtocolVersion,
+		Format:          c.format,
+		Options:         c.options,
+		Document:        doc,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode plugin request: %w", err)
+	}
+
+	stderr := &limitedBuffer{limit: maxStderr}
+
+	cmd := exec.CommandContext(ctx, c.command) //nolint:gosec // Runs the configured or discovered plugin
+	cmd.Stdin = bytes.NewReader(request)
+	cmd.Stdout = w
+	cmd.Stderr = stderr
+	cmd.WaitDelay = waitDelay
+
+	if err := cmd.Run(); err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, fmt.Errorf("plugin %s stopped: %w", c.command, ctxErr)
+		}
+
+		message := strings.TrimSpace(stderr.String())
+
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			if message == "" {
+				return nil, fmt.Errorf("plugin %s exited with code %d", c.command, exitErr.ExitCode())
+			}
+
+			return nil, fmt.Errorf("plugin %s exited with code %d: %s", c.command, exitErr.ExitCode(), message)
+		}
+
+		return nil, fmt.Errorf("failed to run plugin %s: %w", c.command, err)
+	}
+
+	return nil, nil
+}
+
+// Discover finds plugin executables in dirs and then on PATH, returning
+// their paths by format name. The first executable found for a format wins.
+func Discover(dirs []string) map[string]string {
+	found := make(map[string]string)
+
+	dirs = append(append([]string{}, dirs...), filepath.SplitList(os.Getenv("PATH"))...)
+	for _, dir := range dirs {
+		if dir == "" {
+			continue
+		}
+
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+
+		for _, entry := range entries {
+			format, ok := pluginFormat(entry.Name())
+			if !ok || found[format] != "" {
+				continue
+			}
+
+			path := filepath.Join(dir, entry.Name())
+			if isExecutable(path) {
+				found[format] = path
+			}
+		}
+	}
+
+	return found
+}
+
+// pluginFormat returns the format name of a plugin executable name.
+func pluginFormat(name string) (string, bool) {
+	if runtime.GOOS == "windows" {
+		name = strings.TrimSuffix(strings.ToLower(name), ".exe")
+	}
+
+	format := strings.TrimPrefix(name, ExecutablePrefix)
+	if format == name || format == "" {
+		return "", false
+	}
+
+	return format, true
+}
+
+func isExecutable(path string) bool {
+	info, err := os.Stat(path)
+	if err != nil || info.IsDir() {
+		return false
+	}
+
+	return runtime.GOOS == "windows" || info.Mode()&0o111 != 0
+}
+
+// limitedBuffer keeps the first bytes written to it and discards the rest.
+type limitedBuffer struct {
+	bytes.Buffer
+	limit int
+}
+
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	if room := b.limit - b.Len(); room > 0 {
+		if len(p) > room {
+			b.Buffer.Write(p[:room])
+		} else {
+			b.Buffer.Write(p)
+		}
+	}
+
+	return len(p), nil
+}
@@ -0,0 +1,228 @@
+package plugins_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/GabrielNunesIT/openapi-converter/internal/adapters/plugins"
+	"github.com/GabrielNunesIT/openapi-converter/internal/domain"
+)
+
+// pluginModeEnv makes the test binary act as a plugin, behaving as the mode
+// it is set to.
+const pluginModeEnv = "OPENAPI_CONVERTER_TEST_PLUGIN"
+
+func TestMain(m *testing.M) {
+	if mode := os.Getenv(pluginModeEnv); mode != "" {
+		os.Exit(runPlugin(mode))
+	}
+
+	os.Exit(m.Run())
+}
+
+// runPlugin reads a request from stdin and answers it as the given mode.
+func runPlugin(mode string) int {
+	var req plugins.Request
+	if err := json.NewDecoder(os.Stdin).Decode(&req); err != nil {
+		fmt.Fprintf(os.Stderr, "invalid request: %v\n", err)
+		return 2
+	}
+
+	switch mode {
+	case "echo":
+		_ = json.NewEncoder(os.Stdout).Encode(req)
+	case "stream":
+		fmt.Println("# " + req.Document.Title)
+		time.Sleep(time.Minute)
+	case "fail":
+		fmt.Fprintln(os.Stderr, "cannot render callbacks")
+		return 3
+	case "newer":
+		// A plugin built for a later version of the protocol
+		if supported := plugins.ProtocolVersion + 1; req.ProtocolVersion != supported {
+			fmt.Fprintf(os.Stderr, "unsupported protocol version %d (supported: %d)\n", req.ProtocolVersion, supported)
+			return 1
+		}
+	}
+
+	return 0
+}
+
+// pluginConverter returns a converter running the test binary as a plugin.
+func pluginConverter(t *testing.T, mode string, options plugins.Options) *plugins.Converter {
+	t.Helper()
+
+	command, err := os.Executable()
+	if err != nil {
+		t.Fatalf("failed to find the test binary: %v", err)
+	}
+	t.Setenv(pluginModeEnv, mode)
+
+	return plugins.NewConverter("markdown", command, options)
+}
+
+func TestConverterSendsRequest(t *testing.T) {
+	doc := &domain.OpenAPIDocument{Title: "Pets", Version: "1.0", Paths: []domain.Path{{Path: "/pets"}}}
+	options := plugins.Options{GenerateExamples: true, PageSize: "A4"}
+
+	var out bytes.Buffer
+	if err := pluginConverter(t, "echo", options).Convert(doc, &out); err != nil {
+		t.Fatalf("Convert() error = %v", err)
+	}
+
+	var req plugins.Request
+	if err := json.Unmarshal(out.Bytes(), &req); err != nil {
+		t.Fatalf("plugin output is not the request: %v: %s", err, out.String())
+	}
+
+	if req.ProtocolVersion != plugins.ProtocolVersion || req.Format != "markdown" || req.Options != options {
+		t.Errorf("request = %+v, want protocol %d, format markdown and options %+v",
+			req, plugins.ProtocolVersion, options)
+	}
+	if req.Document == nil || req.Document.Title != "Pets" || len(req.Document.Paths) != 1 {
+		t.Errorf("request document = %+v, want the converted document", req.Document)
+	}
+}
+
+// notifyWriter reports the first write to it.
+type notifyWriter struct {
+	mu      sync.Mutex
+	buf     bytes.Buffer
+	written chan struct{}
+}
+
+func (w *notifyWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.buf.Len() == 0 {
+		close(w.written)
+	}
+
+	return w.buf.Write(p)
+}
+
+func TestConverterStreamsOutput(t *testing.T) {
+	converter := pluginConverter(t, "stream", plugins.Options{})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	out := &notifyWriter{written: make(chan struct{})}
+	done := make(chan error, 1)
+
+	go func() {
+		_, err := converter.ConvertContext(ctx, &domain.OpenAPIDocument{Title: "Pets"}, out, domain.ConvertOptions{})
+		done <- err
+	}()
+
+	// The output arrives while the plugin is still running
+	select {
+	case <-out.written:
+	case err := <-done:
+		t.Fatalf("plugin finished before writing: %v", err)
+	case <-time.After(10 * time.Second):
+		t.Fatal("no output while the plugin runs")
+	}
+
+	cancel()
+
+	select {
+	case err := <-done:
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("ConvertContext() error = %v, want context.Canceled", err)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("plugin was not stopped when the context was cancelled")
+	}
+
+	out.mu.Lock()
+	defer out.mu.Unlock()
+
+	if got := out.buf.String(); got != "# Pets\n" {
+		t.Errorf("output = %q, want the first line", got)
+	}
+}
+
+func TestConverterErrors(t *testing.T) {
+	tests := []struct {
+		mode string
+		want string
+	}{
+		{mode: "fail", want: "exited with code 3: cannot render callbacks"},
+		{mode: "newer", want: fmt.Sprintf("exited with code 1: unsupported protocol version %d (supported: %d)",
+			plugins.ProtocolVersion, plugins.ProtocolVersion+1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.mode, func(t *testing.T) {
+			err := pluginConverter(t, tt.mode, plugins.Options{}).Convert(&domain.OpenAPIDocument{}, &bytes.Buffer{})
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Convert() error = %v, want it to contain %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestConverterMissingCommand(t *testing.T) {
+	converter := plugins.NewConverter("markdown", filepath.Join(t.TempDir(), "missing"), plugins.Options{})
+
+	err := converter.Convert(&domain.OpenAPIDocument{}, &bytes.Buffer{})
+	if err == nil || !strings.Contains(err.Error(), "failed to run plugin") {
+		t.Errorf("Convert() error = %v, want a failure to run the plugin", err)
+	}
+}
+
+func TestDiscover(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("executables are found by extension on Windows")
+	}
+
+	first, second := t.TempDir(), t.TempDir()
+	t.Setenv("PATH", "")
+
+	files := []struct {
+		dir  string
+		name string
+		mode os.FileMode
+	}{
+		{dir: first, name: plugins.ExecutablePrefix + "markdown", mode: 0o755},
+		{dir: first, name: plugins.ExecutablePrefix + "rst", mode: 0o644},
+		{dir: first, name: "markdown", mode: 0o755},
+		{dir: second, name: plugins.ExecutablePrefix + "markdown", mode: 0o755},
+		{dir: second, name: plugins.ExecutablePrefix + "html", mode: 0o755},
+	}
+	for _, f := range files {
+		if err := os.WriteFile(filepath.Join(f.dir, f.name), nil, f.mode); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.Mkdir(filepath.Join(second, plugins.ExecutablePrefix+"dir"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	got := plugins.Discover([]string{first, second})
+
+	want := map[string]string{
+		"markdown": filepath.Join(first, plugins.ExecutablePrefix+"markdown"),
+		"html":     filepath.Join(second, plugins.ExecutablePrefix+"html"),
+	}
+	if len(got) != len(want) {
+		t.Fatalf("Discover() = %v, want %v", got, want)
+	}
+	for format, path := range want {
+		if got[format] != path {
+			t.Errorf("Discover()[%s] = %q, want %q", format, got[format], path)
+		}
+	}
+}
@@ -0,0 +1,289 @@
+// Package server converts OpenAPI specifications over HTTP.
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/GabrielNunesIT/go-libs/logger"
+	"github.com/GabrielNunesIT/openapi-converter/internal/adapters/converters"
+	"github.com/GabrielNunesIT/openapi-converter/internal/adapters/loaders"
+	"github.com/GabrielNunesIT/openapi-converter/internal/domain"
+)
+
+// Default limits of a Server.
+const (
+	DefaultMaxBodySize   = 10 << 20 // Bytes
+	DefaultTimeout       = time.Minute
+	DefaultMaxConcurrent = 4
+)
+
+// statusClientClosedRequest is the status recorded for requests whose
+// client went away before the conversion ended.
+const statusClientClosedRequest = 499
+
+// fileName is the base name of converted documents in Content-Disposition.
+const fileName = "api"
+
+// Server converts specifications posted to it:
+//
+//	POST /convert?format=pdf  converts the JSON or YAML specification in the body
+//	GET  /formats             lists the output formats
+//	GET  /healthz             reports that the server is up
+//
+// Specifications are loaded without following external references, so
+// requests cannot make the server read its files or fetch URLs. Plugin
+// formats are not served unless enabled with WithPlugins.
+type Server struct {
+	formats *converters.Registry
+	loader  *loaders.OpenAPILoader
+	log     logger.ILogger
+	plugins bool
+
+	maxBodySize int64
+	timeout     time.Duration
+	slots       chan struct{} // Holds a value per conversion in progress
+}
+
+// Option configures a Server.
+type Option func(*Server)
+
+// WithMaxBodySize sets the largest specification accepted, in bytes.
+func WithMaxBodySize(size int64) Option {
+	return func(s *Server) {
+		s.maxBodySize = size
+	}
+}
+
+// WithTimeout sets how long a conversion may take, including the time spent
+// waiting for a free slot.
+func WithTimeout(timeout time.Duration) Option {
+	return func(s *Server) {
+		s.timeout = timeout
+	}
+}
+
+// WithMaxConcurrent sets how many conversions run at once. Further requests
+// wait for a free slot until their timeout.
+func WithMaxConcurrent(n int) Option {
+	return func(s *Server) {
+		s.slots = make(chan struct{}, max(n, 1))
+	}
+}
+
+// WithPlugins sets whether plugin formats are served. They are not by
+// default, as they run programs on the server with the posted specification.
+func WithPlugins(enabled bool) Option {
+	return func(s *Server) {
+		s.plugins = enabled
+	}
+}
+
+// New creates a server converting to the formats of a registry.
+func New(formats *converters.Registry, log logger.ILogger, opts ...Option) *Server {
+	s := &Server{
+		formats:     formats,
+		loader:      loaders.NewOpenAPILoader(loaders.WithExternalRefs(false)),
+		log:         log,
+		maxBodySize: DefaultMaxBodySize,
+		timeout:     DefaultTimeout,
+		slots:       make(chan struct{}, DefaultMaxConcurrent),
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
+}
+
+// Handler returns the HTTP handler of the server.
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /convert", s.handleConvert)
+	mux.HandleFunc("GET /formats", s.handleFormats)
+	mux.HandleFunc("GET /healthz", s.handleHealth)
+
+	return mux
+}
+
+func (s *Server) handleConvert(w http.ResponseWriter, r *http.Request) {
+	format, settings, err := s.requestFormat(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	data, err := io.ReadAll(http.MaxBytesReader(w, r.Body, s.maxBodySize))
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			writeError(w, http.StatusRequestEntityTooLarge, fmt.Sprintf("specification exceeds %d bytes", tooLarge.Limit))
+			return
+		}
+
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("failed to read request body: %v", err))
+
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), s.timeout)
+	defer cancel()
+
+	// Parsing counts as part of the conversion, so it also waits for a slot
+	select {
+	case s.slots <- struct{}{}:
+		defer func() { <-s.slots }()
+	case <-ctx.Done():
+		if errors.Is(ctx.Err(), context.Canceled) {
+			s.clientClosed(w)
+			return
+		}
+
+		w.Header().Set("Retry-After", "1")
+		writeError(w, http.StatusServiceUnavailable, "too many conversions in progress")
+
+		return
+	}
+
+	start := time.Now()
+
+	doc, _, err := s.loader.LoadData(data, "")
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	var buf bytes.Buffer
+	if _, err := converters.Convert(ctx, format.New(settings), doc, &buf, domain.ConvertOptions{}); err != nil {
+		switch {
+		case errors.Is(err, context.DeadlineExceeded):
+			writeError(w, http.StatusGatewayTimeout, fmt.Sprintf("conversion took longer than %s", s.timeout))
+			return
+		case errors.Is(err, context.Canceled):
+			s.clientClosed(w)
+			return
+		}
+
+		s.log.Errorf("Converting %q to %s failed: %v", doc.Title, format.Name, err)
+		writeError(w, http.StatusInternalServerError, err.Error())
+
+		return
+	}
+
+	s.log.Infof("Converted %q to %s in %s", doc.Title, format.Name, time.Since(start).Round(time.Millisecond))
+
+	contentType := format.MIMEType
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Length", strconv.Itoa(buf.Len()))
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{
+		"filename": fileName + format.Extension,
+	}))
+	_, _ = w.Write(buf.Bytes())
+}
+
+// clientClosed ends a request whose client went away. Nobody reads the
+// response, so it only records the status.
+func (s *Server) clientClosed(w http.ResponseWriter) {
+	s.log.Debugf("Client closed the request before the conversion ended")
+	w.WriteHeader(statusClientClosedRequest)
+}
+
+// requestFormat returns the format named by the format query parameter and
+// the converter settings given by the other parameters.
+func (s *Server) requestFormat(r *http.Request) (converters.Format, converters.Settings, error) {
+	query := r.URL.Query()
+
+	name := query.Get("format")
+	if name == "" {
+		return converters.Format{}, converters.Settings{}, fmt.Errorf("missing format parameter")
+	}
+
+	format, ok := s.formats.Lookup(name)
+	if !ok || !s.servable(format) {
+		return converters.Format{}, converters.Settings{}, fmt.Errorf("unsupported format: %s", name)
+	}
+
+	settings := converters.Settings{
+		PageSize:    query.Get("pageSize"),
+		Orientation: query.Get("orientation"),
+	}
+
+	if settings.PageSize != "" {
+		if err := converters.ValidatePDFPageSize(settings.PageSize); err != nil {
+			return converters.Format{}, converters.Settings{}, fmt.Errorf("invalid pageSize parameter: %w", err)
+		}
+	}
+	if err := converters.ValidatePDFOrientation(settings.Orientation); err != nil {
+		return converters.Format{}, converters.Settings{}, fmt.Errorf("invalid orientation parameter: %w", err)
+	}
+
+	if examples := query.Get("generateExamples"); examples != "" {
+		enabled, err := strconv.ParseBool(examples)
+		if err != nil {
+			return converters.Format{}, converters.Settings{}, fmt.Errorf("invalid generateExamples parameter: %q", examples)
+		}
+		settings.GenerateExamples = enabled
+	}
+
+	return format, settings, nil
+}
+
+// servable reports whether a format can be used over HTTP. The template
+// format renders files from the server's disk and is left out, as are
+// plugins unless they are enabled.
+func (s *Server) servable(format converters.Format) bool {
+	return format.Name != converters.TemplateFormat && (s.plugins || !format.Plugin)
+}
+
+// formatInfo describes an output format in the /formats response.
+type formatInfo struct {
+	Name        string   `json:"name"`
+	Aliases     []string `json:"aliases,omitempty"`
+	Extension   string   `json:"extension,omitempty"`
+	MIMEType    string   `json:"mimeType,omitempty"`
+	Description string   `json:"description,omitempty"`
+}
+
+func (s *Server) handleFormats(w http.ResponseWriter, _ *http.Request) {
+	formats := make([]formatInfo, 0)
+	for _, format := range s.formats.Formats() {
+		if s.servable(format) {
+			formats = append(formats, formatInfo{
+				Name:        format.Name,
+				Aliases:     format.Aliases,
+				Extension:   format.Extension,
+				MIMEType:    format.MIMEType,
+				Description: format.Description,
+			})
+		}
+	}
+
+	writeJSON(w, http.StatusOK, formats)
+}
+
+func (s *Server) handleHealth(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]string{"error": message})
+}
@@ -0,0 +1,364 @@
+package server_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"mime"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/GabrielNunesIT/go-libs/logger"
+	"github.com/GabrielNunesIT/openapi-converter/internal/adapters/converters"
+	"github.com/GabrielNunesIT/openapi-converter/internal/adapters/server"
+	"github.com/GabrielNunesIT/openapi-converter/internal/domain"
+)
+
+const spec = `openapi: 3.0.3
+info:
+  title: Pets API
+  version: 1.0.0
+paths:
+  /pets:
+    get:
+      summary: List pets
+      responses:
+        "200":
+          description: The pets
+`
+
+// newServer starts a test server converting to the built-in formats and to
+// the given ones.
+func newServer(t *testing.T, formats []converters.Format, opts ...server.Option) *httptest.Server {
+	t.Helper()
+
+	registry := converters.DefaultRegistry()
+	for _, format := range formats {
+		if err := registry.Register(format); err != nil {
+			t.Fatalf("failed to register %s: %v", format.Name, err)
+		}
+	}
+
+	srv := httptest.NewServer(server.New(registry, logger.NewConsoleLogger(io.Discard), opts...).Handler())
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+// convert posts a specification to /convert with the given query.
+func convert(t *testing.T, srv *httptest.Server, query, body string) *http.Response {
+	t.Helper()
+
+	resp, err := http.Post(srv.URL+"/convert?"+query, "application/yaml", strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("POST /convert failed: %v", err)
+	}
+	t.Cleanup(func() { resp.Body.Close() })
+
+	return resp
+}
+
+// funcConverter converts with a function, seeing the context of the
+// conversion.
+type funcConverter struct {
+	convert func(ctx context.Context, w io.Writer) error
+}
+
+func (c funcConverter) Format() string { return "func" }
+
+func (c funcConverter) Convert(_ *domain.OpenAPIDocument, w io.Writer) error {
+	return c.convert(context.Background(), w)
+}
+
+func (c funcConverter) ConvertContext(ctx context.Context, _ *domain.OpenAPIDocument, w io.Writer,
+	_ domain.ConvertOptions,
+) ([]domain.Warning, error) {
+	return nil, c.convert(ctx, w)
+}
+
+func funcFormat(name string, convert func(ctx context.Context, w io.Writer) error) converters.Format {
+	return converters.Format{
+		Name: name,
+		New:  func(converters.Settings) domain.Converter { return funcConverter{convert: convert} },
+	}
+}
+
+func TestConvertFormats(t *testing.T) {
+	srv := newServer(t, nil)
+
+	for _, format := range converters.DefaultRegistry().Formats() {
+		if format.Name == converters.TemplateFormat {
+			continue
+		}
+
+		t.Run(format.Name, func(t *testing.T) {
+			resp := convert(t, srv, "format="+format.Name+"&generateExamples=true", spec)
+			body, _ := io.ReadAll(resp.Body)
+
+			if resp.StatusCode != http.StatusOK {
+				t.Fatalf("status = %d, want 200: %s", resp.StatusCode, body)
+			}
+			if got := resp.Header.Get("Content-Type"); got != format.MIMEType {
+				t.Errorf("Content-Type = %q, want %q", got, format.MIMEType)
+			}
+			_, params, err := mime.ParseMediaType(resp.Header.Get("Content-Disposition"))
+			if err != nil || params["filename"] != "api"+format.Extension {
+				t.Errorf("Content-Disposition = %q, want file name api%s", resp.Header.Get("Content-Disposition"), format.Extension)
+			}
+			if len(body) == 0 {
+				t.Error("body is empty")
+			}
+		})
+	}
+}
+
+func TestConvertRejectsRequests(t *testing.T) {
+	plugin := funcFormat("markdown", func(_ context.Context, w io.Writer) error {
+		_, err := io.WriteString(w, "# Pets API\n")
+		return err
+	})
+	plugin.Plugin = true
+
+	const badRequest = http.StatusBadRequest
+
+	tests := []struct {
+		name   string
+		opts   []server.Option
+		query  string
+		body   string
+		status int
+		want   string
+	}{
+		{name: "missing format", query: "", body: spec, status: badRequest, want: "missing format"},
+		{name: "unknown format", query: "format=rtf", body: spec, status: badRequest, want: "unsupported format"},
+		{name: "template format", query: "format=template", body: spec, status: badRequest, want: "unsupported format"},
+		{name: "plugin format", query: "format=markdown", body: spec, status: badRequest, want: "unsupported format"},
+		{
+			name:   "invalid examples parameter",
+			query:  "format=pdf&generateExamples=maybe",
+			body:   spec,
+			status: badRequest,
+			want:   "invalid generateExamples",
+		},
+		{
+			name:   "invalid page size",
+			query:  "format=pdf&pageSize=B9",
+			body:   spec,
+			status: badRequest,
+			want:   "invalid pageSize parameter: unsupported page size: B9",
+		},
+		{
+			name:   "invalid orientation",
+			query:  "format=pdf&orientation=sideways",
+			body:   spec,
+			status: badRequest,
+			want:   "invalid orientation parameter: unsupported orientation: sideways",
+		},
+		{name: "invalid specification", query: "format=pdf", body: "openapi: [", status: badRequest, want: "failed to parse"},
+		{
+			name:   "too large",
+			opts:   []server.Option{server.WithMaxBodySize(64)},
+			query:  "format=pdf",
+			body:   spec,
+			status: http.StatusRequestEntityTooLarge,
+			want:   "exceeds 64 bytes",
+		},
+		{
+			name:   "allowed plugin format",
+			opts:   []server.Option{server.WithPlugins(true)},
+			query:  "format=markdown",
+			body:   spec,
+			status: http.StatusOK,
+			want:   "# Pets API",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := newServer(t, []converters.Format{plugin}, tt.opts...)
+
+			resp := convert(t, srv, tt.query, tt.body)
+			body, _ := io.ReadAll(resp.Body)
+
+			if resp.StatusCode != tt.status {
+				t.Errorf("status = %d, want %d: %s", resp.StatusCode, tt.status, body)
+			}
+			if !strings.Contains(string(body), tt.want) {
+				t.Errorf("body = %q, want it to contain %q", body, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvertTimeout(t *testing.T) {
+	slow := funcFormat("slow", func(ctx context.Context, _ io.Writer) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+
+	srv := newServer(t, []converters.Format{slow}, server.WithTimeout(50*time.Millisecond))
+
+	resp := convert(t, srv, "format=slow", spec)
+	if resp.StatusCode != http.StatusGatewayTimeout {
+		body, _ := io.ReadAll(resp.Body)
+		t.Errorf("status = %d, want 504: %s", resp.StatusCode, body)
+	}
+}
+
+func TestConvertClientClosed(t *testing.T) {
+	started := make(chan struct{})
+	waiting := funcFormat("waiting", func(ctx context.Context, _ io.Writer) error {
+		close(started)
+		<-ctx.Done()
+		return ctx.Err()
+	})
+
+	registry := converters.DefaultRegistry()
+	if err := registry.Register(waiting); err != nil {
+		t.Fatal(err)
+	}
+
+	var logs bytes.Buffer
+	handler := server.New(registry, logger.NewConsoleLogger(&logs)).Handler()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	req := httptest.NewRequestWithContext(ctx, http.MethodPost, "/convert?format=waiting", strings.NewReader(spec))
+	rec := httptest.NewRecorder()
+
+	go func() {
+		<-started
+		cancel()
+	}()
+	handler.ServeHTTP(rec, req)
+
+	// Recorded as nginx's 499 Client Closed Request, not as a failure
+	if rec.Code != 499 {
+		t.Errorf("status = %d, want 499", rec.Code)
+	}
+	if strings.Contains(logs.String(), "failed") {
+		t.Errorf("logs = %q, want no conversion failure", logs.String())
+	}
+}
+
+func TestConvertBusy(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+
+	// Holds the only slot until released, whatever its context
+	blocking := funcFormat("blocking", func(_ context.Context, w io.Writer) error {
+		close(started)
+		<-release
+		_, err := io.WriteString(w, "done")
+		return err
+	})
+
+	srv := newServer(t, []converters.Format{blocking},
+		server.WithMaxConcurrent(1), server.WithTimeout(100*time.Millisecond))
+
+	first := make(chan *http.Response, 1)
+	go func() {
+		resp, err := http.Post(srv.URL+"/convert?format=blocking", "application/yaml", strings.NewReader(spec))
+		if err != nil {
+			t.Errorf("first POST /convert failed: %v", err)
+		}
+		first <- resp
+	}()
+
+	<-started
+
+	// Specifications are only parsed once there is a slot, so even an
+	// invalid one waits for it
+	for _, body := range []string{spec, "openapi: [3"} {
+		resp := convert(t, srv, "format=pdf", body)
+		if resp.StatusCode != http.StatusServiceUnavailable {
+			body, _ := io.ReadAll(resp.Body)
+			t.Errorf("status = %d, want 503: %s", resp.StatusCode, body)
+		}
+		if resp.Header.Get("Retry-After") == "" {
+			t.Error("Retry-After is not set")
+		}
+	}
+
+	close(release)
+
+	if resp := <-first; resp != nil {
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Errorf("first conversion status = %d, want 200", resp.StatusCode)
+		}
+	}
+}
+
+func TestFormats(t *testing.T) {
+	plugin := funcFormat("markdown", func(context.Context, io.Writer) error { return nil })
+	plugin.Plugin = true
+
+	tests := []struct {
+		name string
+		opts []server.Option
+		want bool // Whether the plugin is listed
+	}{
+		{name: "plugins disabled"},
+		{name: "plugins enabled", opts: []server.Option{server.WithPlugins(true)}, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := newServer(t, []converters.Format{plugin}, tt.opts...)
+
+			resp, err := http.Get(srv.URL + "/formats")
+			if err != nil {
+				t.Fatalf("GET /formats failed: %v", err)
+			}
+			defer resp.Body.Close()
+
+			var formats []struct {
+				Name      string `json:"name"`
+				Extension string `json:"extension"`
+				MIMEType  string `json:"mimeType"`
+			}
+			if err := json.NewDecoder(resp.Body).Decode(&formats); err != nil {
+				t.Fatalf("failed to decode formats: %v", err)
+			}
+
+			var names []string
+			for _, format := range formats {
+				names = append(names, format.Name)
+			}
+
+			if slices.Contains(names, converters.TemplateFormat) {
+				t.Errorf("formats = %v, should not list the template format", names)
+			}
+			if !slices.Contains(names, "pdf") {
+				t.Errorf("formats = %v, want them to list pdf", names)
+			}
+			if got := slices.Contains(names, "markdown"); got != tt.want {
+				t.Errorf("formats = %v, plugin listed = %v, want %v", names, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHealth(t *testing.T) {
+	srv := newServer(t, nil)
+
+	resp, err := http.Get(srv.URL + "/healthz")
+	if err != nil {
+		t.Fatalf("GET /healthz failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	var health map[string]string
+	if err := json.NewDecoder(resp.Body).Decode(&health); err != nil {
+		t.Fatalf("failed to decode health: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusOK || health["status"] != "ok" {
+		t.Errorf("GET /healthz = %d %v, want 200 with status ok", resp.StatusCode, health)
+	}
+}
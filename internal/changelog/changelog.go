@@ -0,0 +1,584 @@
+// Package changelog compares two versions of an API and reports what changed
+// between them.
+package changelog
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/GabrielNunesIT/openapi-converter/internal/domain"
+)
+
+// Rules identify the kind of a change.
+const (
+	RuleOperationAdded               = "operation-added"
+	RuleOperationRemoved             = "operation-removed"
+	RuleParameterAdded               = "parameter-added"
+	RuleRequiredParameterAdded       = "required-parameter-added"
+	RuleParameterRemoved             = "parameter-removed"
+	RuleParameterBecameRequired      = "parameter-became-required"
+	RuleParameterBecameOptional      = "parameter-became-optional"
+	RuleRequestBodyAdded             = "request-body-added"
+	RuleRequiredRequestBodyAdded     = "required-request-body-added"
+	RuleRequestBodyRemoved           = "request-body-removed"
+	RuleRequestBodyBecameRequired    = "request-body-became-required"
+	RuleRequestBodyBecameOptional    = "request-body-became-optional"
+	RuleMediaTypeAdded               = "media-type-added"
+	RuleMediaTypeRemoved             = "media-type-removed"
+	RuleResponseAdded                = "response-added"
+	RuleResponseRemoved              = "response-removed"
+	RuleSuccessResponseRemoved       = "success-response-removed"
+	RulePropertyAdded                = "property-added"
+	RuleRequiredRequestPropertyAdded = "required-request-property-added"
+	RuleRequestPropertyRemoved       = "request-property-removed"
+	RuleResponsePropertyRemoved      = "response-property-removed"
+	RuleTypeChanged                  = "type-changed"
+	RuleFormatChanged                = "format-changed"
+
+	// Value changes, whose effect depends on whether clients send or receive
+	// the schema
+	RuleRequestEnumValueAdded       = "request-enum-value-added"
+	RuleRequestEnumValueRemoved     = "request-enum-value-removed"
+	RuleResponseEnumValueAdded      = "response-enum-value-added"
+	RuleResponseEnumValueRemoved    = "response-enum-value-removed"
+	RuleRequestConstraintTightened  = "request-constraint-tightened"
+	RuleRequestConstraintLoosened   = "request-constraint-loosened"
+	RuleResponseConstraintTightened = "response-constraint-tightened"
+	RuleResponseConstraintLoosened  = "response-constraint-loosened"
+
+	// Properties becoming required or optional, whose effect also depends on
+	// the direction of the schema
+	RuleRequestPropertyBecameRequired  = "request-property-became-required"
+	RuleRequestPropertyBecameOptional  = "request-property-became-optional"
+	RuleResponsePropertyBecameRequired = "response-property-became-required"
+	RuleResponsePropertyBecameOptional = "response-property-became-optional"
+)
+
+// breakingRules lists the rules whose changes can break existing clients.
+var breakingRules = map[string]bool{
+	RuleOperationRemoved:             true,
+	RuleRequiredParameterAdded:       true,
+	RuleParameterRemoved:             true,
+	RuleParameterBecameRequired:      true,
+	RuleRequiredRequestBodyAdded:     true,
+	RuleRequestBodyBecameRequired:    true,
+	RuleMediaTypeRemoved:             true,
+	RuleSuccessResponseRemoved:       true,
+	RuleRequestPropertyRemoved:       true,
+	RuleResponsePropertyRemoved:      true,
+	RuleRequiredRequestPropertyAdded: true,
+	RuleTypeChanged:                  true,
+
+	// Clients must send values the server accepts, and accept the values it
+	// sends
+	RuleRequestEnumValueRemoved:    true,
+	RuleRequestConstraintTightened: true,
+	RuleResponseEnumValueAdded:     true,
+	RuleResponseConstraintLoosened: true,
+
+	// Clients must send the properties the server requires, and may rely on
+	// those it always sends
+	RuleRequestPropertyBecameRequired:  true,
+	RuleResponsePropertyBecameOptional: true,
+}
+
+// maxSchemaDepth bounds how deep nested schemas are compared.
+const maxSchemaDepth = 10
+
+// Change is a single difference between two versions of an API.
+type Change struct {
+	Rule      string `json:"rule"`
+	Breaking  bool   `json:"breaking"`
+	Operation string `json:"operation"`          // "METHOD /path"
+	Location  string `json:"location,omitempty"` // Parameter, body or response the change is in
+	Message   string `json:"message"`
+}
+
+// Report lists the changes between a base and a revised API.
+type Report struct {
+	Title           string   `json:"title"`
+	BaseVersion     string   `json:"baseVersion"`
+	RevisionVersion string   `json:"revisionVersion"`
+	Changes         []Change `json:"changes"`
+}
+
+// Breaking returns the changes that can break existing clients.
+func (r *Report) Breaking() []Change {
+	var breaking []Change
+	for _, change := range r.Changes {
+		if change.Breaking {
+			breaking = append(breaking, change)
+		}
+	}
+
+	return breaking
+}
+
+// Compare computes the changes from base to revision.
+func Compare(base, revision *domain.OpenAPIDocument) *Report {
+	d := &differ{}
+
+	baseOps := operationsByKey(base)
+	revisionOps := operationsByKey(revision)
+
+	for _, key := range sortedKeys(baseOps) {
+		if _, ok := revisionOps[key]; !ok {
+			d.add(RuleOperationRemoved, key, "", "Operation removed")
+		}
+	}
+
+	for _, key := range sortedKeys(revisionOps) {
+		baseOp, ok := baseOps[key]
+		if !ok {
+			d.add(RuleOperationAdded, key, "", "Operation added")
+			continue
+		}
+
+		d.operation(key, baseOp, revisionOps[key])
+	}
+
+	return &Report{
+		Title:           revision.Title,
+		BaseVersion:     base.Version,
+		RevisionVersion: revision.Version,
+		Changes:         d.changes,
+	}
+}
+
+type differ struct {
+	changes []Change
+}
+
+func (d *differ) add(rule, operation, location, format string, args ...any) {
+	d.changes = append(d.changes, Change{
+		Rule:      rule,
+		Breaking:  breakingRules[rule],
+		Operation: operation,
+		Location:  location,
+		Message:   fmt.Sprintf(format, args...),
+	})
+}
+
+func (d *differ) operation(key string, base, revision domain.Operation) {
+	d.parameters(key, base.Parameters, revision.Parameters)
+	d.requestBody(key, base.RequestBody, revision.RequestBody)
+	d.responses(key, base.Responses, revision.Responses)
+}
+
+func (d *differ) parameters(key string, base, revision []domain.Parameter) {
+	baseParams := parametersByKey(base)
+	revisionParams := parametersByKey(revision)
+
+	for _, name := range sortedKeys(baseParams) {
+		if _, ok := revisionParams[name]; !ok {
+			d.add(RuleParameterRemoved, key, name, "Parameter %s removed", parameterLabel(baseParams[name]))
+		}
+	}
+
+	for _, name := range sortedKeys(revisionParams) {
+		param := revisionParams[name]
+		label := parameterLabel(param)
+
+		baseParam, ok := baseParams[name]
+		switch {
+		case !ok && param.Required:
+			d.add(RuleRequiredParameterAdded, key, name, "Required parameter %s added", label)
+			continue
+		case !ok:
+			d.add(RuleParameterAdded, key, name, "Optional parameter %s added", label)
+			continue
+		case !baseParam.Required && param.Required:
+			d.add(RuleParameterBecameRequired, key, name, "Parameter %s became required", label)
+		case baseParam.Required && !param.Required:
+			d.add(RuleParameterBecameOptional, key, name, "Parameter %s became optional", label)
+		}
+
+		d.schema(key, name, "", "parameter "+label, baseParam.Schema, param.Schema, true, 0)
+	}
+}
+
+func (d *differ) requestBody(key string, base, revision *domain.RequestBody) {
+	switch {
+	case base == nil && revision == nil:
+		return
+	case base == nil && revision.Required:
+		d.add(RuleRequiredRequestBodyAdded, key, "request body", "Required request body added")
+		return
+	case base == nil:
+		d.add(RuleRequestBodyAdded, key, "request body", "Optional request body added")
+		return
+	case revision == nil:
+		d.add(RuleRequestBodyRemoved, key, "request body", "Request body removed")
+		return
+	case !base.Required && revision.Required:
+		d.add(RuleRequestBodyBecameRequired, key, "request body", "Request body became required")
+	case base.Required && !revision.Required:
+		d.add(RuleRequestBodyBecameOptional, key, "request body", "Request body became optional")
+	}
+
+	d.content(key, "request body", base.Content, revision.Content, true)
+}
+
+func (d *differ) responses(key string, base, revision []domain.Response) {
+	baseResponses := make(map[string]domain.Response, len(base))
+	for _, resp := range base {
+		baseResponses[resp.StatusCode] = resp
+	}
+
+	revisionResponses := make(map[string]domain.Response, len(revision))
+	for _, resp := range revision {
+		revisionResponses[resp.StatusCode] = resp
+	}
+
+	for _, status := range sortedKeys(baseResponses) {
+		if _, ok := revisionResponses[status]; ok {
+			continue
+		}
+
+		location := "response " + status
+		if strings.HasPrefix(status, "2") {
+			d.add(RuleSuccessResponseRemoved, key, location, "Response `%s` removed", status)
+		} else {
+			d.add(RuleResponseRemoved, key, location, "Response `%s` removed", status)
+		}
+	}
+
+	for _, status := range sortedKeys(revisionResponses) {
+		location := "response " + status
+
+		baseResp, ok := baseResponses[status]
+		if !ok {
+			d.add(RuleResponseAdded, key, location, "Response `%s` added", status)
+			continue
+		}
+
+		d.content(key, location, baseResp.Content, revisionResponses[status].Content, false)
+	}
+}
+
+// content compares the media types of a request or response body.
+func (d *differ) content(key, location string, base, revision map[string]domain.MediaType, request bool) {
+	for _, mediaType := range sortedKeys(base) {
+		if _, ok := revision[mediaType]; !ok {
+			d.add(RuleMediaTypeRemoved, key, location, "Media type `%s` removed from %s", mediaType, location)
+		}
+	}
+
+	for _, mediaType := range sortedKeys(revision) {
+		baseMedia, ok := base[mediaType]
+		if !ok {
+			d.add(RuleMediaTypeAdded, key, location, "Media type `%s` added to %s", mediaType, location)
+			continue
+		}
+
+		subject := location
+		if len(revision) > 1 {
+			subject = fmt.Sprintf("%s (%s)", location, mediaType)
+		}
+
+		d.schema(key, location, "", subject, baseMedia.Schema, revision[mediaType].Schema, request, 0)
+	}
+}
+
+// schema compares two schemas. Field is the dotted path of the schema within
+// the body, empty for the body itself, and container names the parameter or
+// body the schema belongs to. Request schemas are sent by clients, so
+// removing a property from them breaks callers that still send it; response
+// schemas break callers when properties disappear.
+//
+//nolint:cyclop // One check per schema aspect reads clearer than splitting them up
+func (d *differ) schema(key, location, field, container string, base, revision domain.Schema, request bool, depth int) {
+	if depth > maxSchemaDepth {
+		return
+	}
+
+	subject := container
+	if field != "" {
+		subject = fmt.Sprintf("`%s` in %s", field, container)
+	}
+
+	if base.Type != "" && revision.Type != "" && base.Type != revision.Type {
+		d.add(RuleTypeChanged, key, location, "Type of %s changed from `%s` to `%s`", subject, base.Type, revision.Type)
+		return
+	}
+	if base.Format != revision.Format && base.Type == revision.Type {
+		d.add(RuleFormatChanged, key, location, "Format of %s changed from `%s` to `%s`", subject,
+			valueOrNone(base.Format), valueOrNone(revision.Format))
+	}
+
+	d.enum(key, location, subject, base.Enum, revision.Enum, request)
+	d.constraints(key, location, subject, base, revision, request)
+
+	for _, name := range sortedKeys(base.Properties) {
+		if _, ok := revision.Properties[name]; ok {
+			continue
+		}
+
+		prop := joinField(field, name)
+		if request {
+			d.add(RuleRequestPropertyRemoved, key, location, "Property `%s` removed from %s", prop, container)
+		} else {
+			d.add(RuleResponsePropertyRemoved, key, location, "Property `%s` removed from %s", prop, container)
+		}
+	}
+
+	for _, name := range sortedKeys(revision.Properties) {
+		prop := joinField(field, name)
+		required := slices.Contains(revision.Required, name)
+
+		baseProp, ok := base.Properties[name]
+		switch {
+		case !ok && request && required:
+			d.add(RuleRequiredRequestPropertyAdded, key, location, "Required property `%s` added to %s", prop, container)
+			continue
+		case !ok:
+			d.add(RulePropertyAdded, key, location, "Property `%s` added to %s", prop, container)
+			continue
+		}
+
+		d.required(key, location, prop, container, slices.Contains(base.Required, name), required, request)
+		d.schema(key, location, joinField(field, name), container, baseProp, revision.Properties[name], request, depth+1)
+	}
+
+	if base.Items != nil && revision.Items != nil {
+		d.schema(key, location, field+"[]", container, *base.Items, *revision.Items, request, depth+1)
+	}
+}
+
+// required reports a property that became required or optional. Requiring a
+// property breaks clients sending requests without it, and making one
+// optional breaks clients relying on responses to include it.
+func (d *differ) required(key, location, prop, container string, base, revision, request bool) {
+	if base == revision {
+		return
+	}
+
+	requiredRule, optionalRule := RuleResponsePropertyBecameRequired, RuleResponsePropertyBecameOptional
+	if request {
+		requiredRule, optionalRule = RuleRequestPropertyBecameRequired, RuleRequestPropertyBecameOptional
+	}
+
+	if revision {
+		d.add(requiredRule, key, location, "Property `%s` in %s became required", prop, container)
+	} else {
+		d.add(optionalRule, key, location, "Property `%s` in %s became optional", prop, container)
+	}
+}
+
+// enum reports values added to or removed from an enumeration. Adding an
+// enumeration to a schema that had none narrows it as well, and dropping one
+// widens it. Narrowing breaks clients sending requests, widening breaks
+// clients reading responses.
+func (d *differ) enum(key, location, subject string, base, revision []interface{}, request bool) {
+	if len(base) == 0 && len(revision) == 0 {
+		return
+	}
+
+	addedRule, removedRule := RuleResponseEnumValueAdded, RuleResponseEnumValueRemoved
+	if request {
+		addedRule, removedRule = RuleRequestEnumValueAdded, RuleRequestEnumValueRemoved
+	}
+
+	baseValues := enumValues(base)
+	revisionValues := enumValues(revision)
+
+	switch {
+	case len(base) == 0:
+		d.add(removedRule, key, location, "Values of %s restricted to %s", subject, formatValues(sortedKeys(revisionValues)))
+		return
+	case len(revision) == 0:
+		d.add(addedRule, key, location, "Values of %s no longer restricted to %s", subject,
+			formatValues(sortedKeys(baseValues)))
+		return
+	}
+
+	var removed, added []string
+	for _, v := range sortedKeys(baseValues) {
+		if !revisionValues[v] {
+			removed = append(removed, v)
+		}
+	}
+	for _, v := range sortedKeys(revisionValues) {
+		if !baseValues[v] {
+			added = append(added, v)
+		}
+	}
+
+	if len(removed) > 0 {
+		d.add(removedRule, key, location, "Values %s removed from %s", formatValues(removed), subject)
+	}
+	if len(added) > 0 {
+		d.add(addedRule, key, location, "Values %s added to %s", formatValues(added), subject)
+	}
+}
+
+// limit is a bound of a schema, nil when the schema has none.
+type limit struct {
+	value     *float64
+	exclusive bool
+}
+
+func countLimit(n *uint64) limit {
+	if n == nil {
+		return limit{}
+	}
+
+	v := float64(*n)
+
+	return limit{value: &v}
+}
+
+// minCountLimit treats a zero minimum length or item count as no bound.
+func minCountLimit(n uint64) limit {
+	if n == 0 {
+		return limit{}
+	}
+
+	return countLimit(&n)
+}
+
+func (l limit) String() string {
+	if l.value == nil {
+		return "none"
+	}
+
+	s := "`" + strconv.FormatFloat(*l.value, 'f', -1, 64) + "`"
+	if l.exclusive {
+		s += " (exclusive)"
+	}
+
+	return s
+}
+
+// constraints reports the bounds on values, lengths and item counts that were
+// tightened or loosened. Tightening breaks clients sending requests,
+// loosening breaks clients reading responses.
+func (d *differ) constraints(key, location, subject string, base, revision domain.Schema, request bool) {
+	bounds := []struct {
+		name           string
+		upper          bool
+		base, revision limit
+	}{
+		{"Minimum", false, limit{base.Minimum, base.ExclusiveMinimum}, limit{revision.Minimum, revision.ExclusiveMinimum}},
+		{"Maximum", true, limit{base.Maximum, base.ExclusiveMaximum}, limit{revision.Maximum, revision.ExclusiveMaximum}},
+		{"Minimum length", false, minCountLimit(base.MinLength), minCountLimit(revision.MinLength)},
+		{"Maximum length", true, countLimit(base.MaxLength), countLimit(revision.MaxLength)},
+		{"Minimum items", false, minCountLimit(base.MinItems), minCountLimit(revision.MinItems)},
+		{"Maximum items", true, countLimit(base.MaxItems), countLimit(revision.MaxItems)},
+	}
+
+	tightenedRule, loosenedRule := RuleResponseConstraintTightened, RuleResponseConstraintLoosened
+	if request {
+		tightenedRule, loosenedRule = RuleRequestConstraintTightened, RuleRequestConstraintLoosened
+	}
+
+	for _, b := range bounds {
+		switch compareLimits(b.base, b.revision, b.upper) {
+		case 1:
+			d.add(tightenedRule, key, location, "%s of %s tightened from %s to %s", b.name, subject, b.base, b.revision)
+		case -1:
+			d.add(loosenedRule, key, location, "%s of %s loosened from %s to %s", b.name, subject, b.base, b.revision)
+		}
+	}
+}
+
+// compareLimits returns 1 when revision allows fewer values than base, -1
+// when it allows more and 0 when they are the same.
+func compareLimits(base, revision limit, upper bool) int {
+	switch {
+	case base.value == nil && revision.value == nil:
+		return 0
+	case base.value == nil:
+		return 1
+	case revision.value == nil:
+		return -1
+	}
+
+	b, r := *base.value, *revision.value
+	if upper {
+		b, r = -b, -r
+	}
+
+	switch {
+	case r > b, r == b && revision.exclusive && !base.exclusive:
+		return 1
+	case r < b, r == b && base.exclusive && !revision.exclusive:
+		return -1
+	default:
+		return 0
+	}
+}
+
+func enumValues(values []interface{}) map[string]bool {
+	result := make(map[string]bool, len(values))
+	for _, v := range values {
+		result[fmt.Sprint(v)] = true
+	}
+
+	return result
+}
+
+func formatValues(values []string) string {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = "`" + v + "`"
+	}
+
+	return strings.Join(quoted, ", ")
+}
+
+func joinField(parent, name string) string {
+	if parent == "" {
+		return name
+	}
+
+	return parent + "." + name
+}
+
+func valueOrNone(s string) string {
+	if s == "" {
+		return "none"
+	}
+
+	return s
+}
+
+// operationsByKey indexes the operations of a document by "METHOD /path".
+func operationsByKey(doc *domain.OpenAPIDocument) map[string]domain.Operation {
+	result := make(map[string]domain.Operation)
+
+	for _, path := range doc.Paths {
+		for _, op := range path.Operations {
+			result[strings.ToUpper(op.Method)+" "+path.Path] = op
+		}
+	}
+
+	return result
+}
+
+// parametersByKey indexes parameters by their location and name.
+func parametersByKey(params []domain.Parameter) map[string]domain.Parameter {
+	result := make(map[string]domain.Parameter, len(params))
+	for _, param := range params {
+		result[param.In+" parameter "+param.Name] = param
+	}
+
+	return result
+}
+
+func parameterLabel(param domain.Parameter) string {
+	return fmt.Sprintf("`%s` in %s", param.Name, param.In)
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
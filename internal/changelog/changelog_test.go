@@ -0,0 +1,302 @@
+package changelog_test
+
+import (
+	"testing"
+
+	"github.com/GabrielNunesIT/openapi-converter/internal/changelog"
+	"github.com/GabrielNunesIT/openapi-converter/internal/domain"
+)
+
+// document returns a document with one operation taking schema as its
+// request body and returning it as its response.
+func document(request, response domain.Schema) *domain.OpenAPIDocument {
+	return &domain.OpenAPIDocument{
+		Title:   "API",
+		Version: "1",
+		Paths: []domain.Path{{
+			Path: "/items",
+			Operations: []domain.Operation{{
+				Method: "post",
+				RequestBody: &domain.RequestBody{
+					Content: map[string]domain.MediaType{"application/json": {Schema: request}},
+				},
+				Responses: []domain.Response{{
+					StatusCode: "200",
+					Content:    map[string]domain.MediaType{"application/json": {Schema: response}},
+				}},
+			}},
+		}},
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestCompareValueChanges(t *testing.T) {
+	str := domain.Schema{Type: "string"}
+	num := domain.Schema{Type: "number"}
+
+	tests := []struct {
+		name     string
+		base     domain.Schema
+		revision domain.Schema
+		request  []string // Rules reported for the request body
+		response []string // Rules reported for the response body
+	}{
+		{
+			name:     "enum value added",
+			base:     domain.Schema{Type: "string", Enum: []any{"a"}},
+			revision: domain.Schema{Type: "string", Enum: []any{"a", "b"}},
+			request:  []string{changelog.RuleRequestEnumValueAdded},
+			response: []string{changelog.RuleResponseEnumValueAdded},
+		},
+		{
+			name:     "enum value removed",
+			base:     domain.Schema{Type: "string", Enum: []any{"a", "b"}},
+			revision: domain.Schema{Type: "string", Enum: []any{"a"}},
+			request:  []string{changelog.RuleRequestEnumValueRemoved},
+			response: []string{changelog.RuleResponseEnumValueRemoved},
+		},
+		{
+			name:     "enum added",
+			base:     str,
+			revision: domain.Schema{Type: "string", Enum: []any{"a"}},
+			request:  []string{changelog.RuleRequestEnumValueRemoved},
+			response: []string{changelog.RuleResponseEnumValueRemoved},
+		},
+		{
+			name:     "enum dropped",
+			base:     domain.Schema{Type: "string", Enum: []any{"a"}},
+			revision: str,
+			request:  []string{changelog.RuleRequestEnumValueAdded},
+			response: []string{changelog.RuleResponseEnumValueAdded},
+		},
+		{
+			name:     "maximum lowered",
+			base:     domain.Schema{Type: "number", Maximum: ptr(100.0)},
+			revision: domain.Schema{Type: "number", Maximum: ptr(50.0)},
+			request:  []string{changelog.RuleRequestConstraintTightened},
+			response: []string{changelog.RuleResponseConstraintTightened},
+		},
+		{
+			name:     "minimum removed",
+			base:     domain.Schema{Type: "number", Minimum: ptr(1.0)},
+			revision: num,
+			request:  []string{changelog.RuleRequestConstraintLoosened},
+			response: []string{changelog.RuleResponseConstraintLoosened},
+		},
+		{
+			name:     "minimum became exclusive",
+			base:     domain.Schema{Type: "number", Minimum: ptr(0.0)},
+			revision: domain.Schema{Type: "number", Minimum: ptr(0.0), ExclusiveMinimum: true},
+			request:  []string{changelog.RuleRequestConstraintTightened},
+			response: []string{changelog.RuleResponseConstraintTightened},
+		},
+		{
+			name:     "maximum length raised",
+			base:     domain.Schema{Type: "string", MaxLength: ptr(uint64(10))},
+			revision: domain.Schema{Type: "string", MaxLength: ptr(uint64(20))},
+			request:  []string{changelog.RuleRequestConstraintLoosened},
+			response: []string{changelog.RuleResponseConstraintLoosened},
+		},
+		{
+			name:     "minimum length added",
+			base:     str,
+			revision: domain.Schema{Type: "string", MinLength: 3},
+			request:  []string{changelog.RuleRequestConstraintTightened},
+			response: []string{changelog.RuleResponseConstraintTightened},
+		},
+		{
+			name:     "item counts changed",
+			base:     domain.Schema{Type: "array", Items: &str, MinItems: 1},
+			revision: domain.Schema{Type: "array", Items: &str, MinItems: 2, MaxItems: ptr(uint64(5))},
+			request:  []string{changelog.RuleRequestConstraintTightened, changelog.RuleRequestConstraintTightened},
+			response: []string{changelog.RuleResponseConstraintTightened, changelog.RuleResponseConstraintTightened},
+		},
+		{
+			name:     "unchanged",
+			base:     domain.Schema{Type: "number", Minimum: ptr(1.0), Maximum: ptr(2.0), Enum: []any{1.0, 2.0}},
+			revision: domain.Schema{Type: "number", Minimum: ptr(1.0), Maximum: ptr(2.0), Enum: []any{2.0, 1.0}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Run("request", func(t *testing.T) {
+				report := changelog.Compare(document(tt.base, num), document(tt.revision, num))
+				assertRules(t, report, tt.request)
+			})
+
+			t.Run("response", func(t *testing.T) {
+				report := changelog.Compare(document(num, tt.base), document(num, tt.revision))
+				assertRules(t, report, tt.response)
+			})
+		})
+	}
+}
+
+func assertRules(t *testing.T, report *changelog.Report, want []string) {
+	t.Helper()
+
+	if len(report.Changes) != len(want) {
+		t.Fatalf("got changes %+v, want rules %v", report.Changes, want)
+	}
+
+	for i, change := range report.Changes {
+		if change.Rule != want[i] {
+			t.Errorf("change %d rule = %s, want %s (%s)", i, change.Rule, want[i], change.Message)
+		}
+	}
+}
+
+func TestCompareBreakingDirection(t *testing.T) {
+	base := domain.Schema{Type: "string", Enum: []any{"a"}, MaxLength: ptr(uint64(10))}
+	revision := domain.Schema{Type: "string", Enum: []any{"a", "b"}, MaxLength: ptr(uint64(20))}
+
+	tests := []struct {
+		name     string
+		base     *domain.OpenAPIDocument
+		revision *domain.OpenAPIDocument
+		breaking int
+	}{
+		{name: "request widened", base: document(base, base), revision: document(revision, base), breaking: 0},
+		{name: "response widened", base: document(base, base), revision: document(base, revision), breaking: 2},
+		{name: "request narrowed", base: document(revision, revision), revision: document(base, revision), breaking: 2},
+		{name: "response narrowed", base: document(revision, revision), revision: document(revision, base), breaking: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report := changelog.Compare(tt.base, tt.revision)
+			if got := len(report.Breaking()); got != tt.breaking {
+				t.Errorf("got %d breaking changes, want %d: %+v", got, tt.breaking, report.Changes)
+			}
+		})
+	}
+}
+
+func TestCompareRequestBodyRequired(t *testing.T) {
+	body := func(required bool) *domain.OpenAPIDocument {
+		doc := document(domain.Schema{Type: "object"}, domain.Schema{Type: "object"})
+		doc.Paths[0].Operations[0].RequestBody.Required = required
+
+		return doc
+	}
+
+	tests := []struct {
+		name     string
+		base     bool
+		revision bool
+		want     []string
+		breaking int
+	}{
+		{name: "became required", revision: true, want: []string{changelog.RuleRequestBodyBecameRequired}, breaking: 1},
+		{name: "became optional", base: true, want: []string{changelog.RuleRequestBodyBecameOptional}},
+		{name: "still required", base: true, revision: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report := changelog.Compare(body(tt.base), body(tt.revision))
+			assertRules(t, report, tt.want)
+
+			if got := len(report.Breaking()); got != tt.breaking {
+				t.Errorf("got %d breaking changes, want %d", got, tt.breaking)
+			}
+		})
+	}
+}
+
+func TestCompareRequiredProperties(t *testing.T) {
+	str := domain.Schema{Type: "string"}
+	object := func(required []string, names ...string) domain.Schema {
+		schema := domain.Schema{Type: "object", Properties: map[string]domain.Schema{}, Required: required}
+		for _, name := range names {
+			schema.Properties[name] = str
+		}
+
+		return schema
+	}
+	nested := func(pet domain.Schema) domain.Schema {
+		return domain.Schema{Type: "object", Properties: map[string]domain.Schema{"pet": pet}}
+	}
+	num := domain.Schema{Type: "number"}
+
+	tests := []struct {
+		name             string
+		base             domain.Schema
+		revision         domain.Schema
+		request          []string // Rules reported for the request body
+		response         []string // Rules reported for the response body
+		requestBreaking  int
+		responseBreaking int
+	}{
+		{
+			name:            "property became required",
+			base:            object(nil, "name"),
+			revision:        object([]string{"name"}, "name"),
+			request:         []string{changelog.RuleRequestPropertyBecameRequired},
+			response:        []string{changelog.RuleResponsePropertyBecameRequired},
+			requestBreaking: 1,
+		},
+		{
+			name:             "property became optional",
+			base:             object([]string{"name"}, "name"),
+			revision:         object(nil, "name"),
+			request:          []string{changelog.RuleRequestPropertyBecameOptional},
+			response:         []string{changelog.RuleResponsePropertyBecameOptional},
+			responseBreaking: 1,
+		},
+		{
+			name:            "required property added",
+			base:            object(nil, "name"),
+			revision:        object([]string{"tag"}, "name", "tag"),
+			request:         []string{changelog.RuleRequiredRequestPropertyAdded},
+			response:        []string{changelog.RulePropertyAdded},
+			requestBreaking: 1,
+		},
+		{
+			name:     "optional property added",
+			base:     object(nil, "name"),
+			revision: object(nil, "name", "tag"),
+			request:  []string{changelog.RulePropertyAdded},
+			response: []string{changelog.RulePropertyAdded},
+		},
+		{
+			name:            "nested property became required",
+			base:            nested(object(nil, "name")),
+			revision:        nested(object([]string{"name"}, "name")),
+			request:         []string{changelog.RuleRequestPropertyBecameRequired},
+			response:        []string{changelog.RuleResponsePropertyBecameRequired},
+			requestBreaking: 1,
+		},
+		{
+			name:     "unchanged",
+			base:     object([]string{"name"}, "name", "tag"),
+			revision: object([]string{"name"}, "name", "tag"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Run("request", func(t *testing.T) {
+				report := changelog.Compare(document(tt.base, num), document(tt.revision, num))
+				assertRules(t, report, tt.request)
+
+				if got := len(report.Breaking()); got != tt.requestBreaking {
+					t.Errorf("got %d breaking changes, want %d", got, tt.requestBreaking)
+				}
+			})
+
+			t.Run("response", func(t *testing.T) {
+				report := changelog.Compare(document(num, tt.base), document(num, tt.revision))
+				assertRules(t, report, tt.response)
+
+				if got := len(report.Breaking()); got != tt.responseBreaking {
+					t.Errorf("got %d breaking changes, want %d", got, tt.responseBreaking)
+				}
+			})
+		})
+	}
+}
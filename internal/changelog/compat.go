@@ -0,0 +1,180 @@
+package changelog
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Severity is how a compatibility check treats a kind of change.
+type Severity string
+
+// Severities, from most to least severe.
+const (
+	SeverityError   Severity = "error"   // Fails the check
+	SeverityWarning Severity = "warning" // Reported, but does not fail the check
+	SeverityInfo    Severity = "info"    // Listed with the other changes
+	SeverityOff     Severity = "off"     // Not reported
+)
+
+// ParseSeverity validates a severity name.
+func ParseSeverity(s string) (Severity, error) {
+	switch severity := Severity(strings.ToLower(s)); severity {
+	case SeverityError, SeverityWarning, SeverityInfo, SeverityOff:
+		return severity, nil
+	default:
+		return "", fmt.Errorf("invalid severity %q (supported: error, warning, info, off)", s)
+	}
+}
+
+// AllowedChange accepts a breaking change, so it no longer fails the check.
+// Empty fields match any value.
+type AllowedChange struct {
+	Rule      string `json:"rule"      yaml:"rule"`
+	Operation string `json:"operation" yaml:"operation"` // "METHOD /path"
+	Location  string `json:"location"  yaml:"location"`
+	Reason    string `json:"reason"    yaml:"reason"`
+}
+
+func (a AllowedChange) matches(change Change) bool {
+	return (a.Rule == "" || a.Rule == change.Rule) &&
+		(a.Operation == "" || strings.EqualFold(a.Operation, change.Operation)) &&
+		(a.Location == "" || a.Location == change.Location)
+}
+
+// allowlistFile is the layout of an allowlist file.
+type allowlistFile struct {
+	Allow []AllowedChange `yaml:"allow"`
+}
+
+// LoadAllowlist reads the accepted changes from a YAML or JSON file with an
+// "allow" list.
+func LoadAllowlist(fileName string) ([]AllowedChange, error) {
+	data, err := os.ReadFile(fileName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read allowlist: %w", err)
+	}
+
+	var file allowlistFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse allowlist %s: %w", fileName, err)
+	}
+
+	for i, allowed := range file.Allow {
+		if allowed.Rule == "" && allowed.Operation == "" && allowed.Location == "" {
+			return nil, fmt.Errorf("allowlist entry %d matches every change; set its rule, operation or location", i+1)
+		}
+	}
+
+	return file.Allow, nil
+}
+
+// Policy decides the severity of each change.
+type Policy struct {
+	// Severities overrides the severity of rules. Breaking changes default
+	// to SeverityError and other changes to SeverityInfo.
+	Severities map[string]Severity
+	Allowlist  []AllowedChange
+}
+
+// Finding is a change with the severity the policy gave it.
+type Finding struct {
+	Change
+	Severity Severity `json:"severity"`
+	Allowed  bool     `json:"allowed,omitempty"` // Accepted by the allowlist
+	Reason   string   `json:"reason,omitempty"`  // Why the allowlist accepts it
+}
+
+// CompatibilityResult is the outcome of a compatibility check.
+type CompatibilityResult struct {
+	BaseVersion     string    `json:"baseVersion"`
+	RevisionVersion string    `json:"revisionVersion"`
+	Compatible      bool      `json:"compatible"`
+	Errors          int       `json:"errors"`
+	Warnings        int       `json:"warnings"`
+	Findings        []Finding `json:"findings"`
+}
+
+// Check applies the policy to a report. The revision is compatible when no
+// change has error severity once allowed changes are set aside.
+func (p *Policy) Check(report *Report) *CompatibilityResult {
+	result := &CompatibilityResult{
+		BaseVersion:     report.BaseVersion,
+		RevisionVersion: report.RevisionVersion,
+		Findings:        make([]Finding, 0, len(report.Changes)),
+	}
+
+	for _, change := range report.Changes {
+		finding := Finding{Change: change, Severity: p.severity(change)}
+		if finding.Severity == SeverityOff {
+			continue
+		}
+
+		for _, allowed := range p.Allowlist {
+			if allowed.matches(change) {
+				finding.Allowed = true
+				finding.Reason = allowed.Reason
+				break
+			}
+		}
+
+		switch {
+		case finding.Allowed:
+		case finding.Severity == SeverityError:
+			result.Errors++
+		case finding.Severity == SeverityWarning:
+			result.Warnings++
+		}
+
+		result.Findings = append(result.Findings, finding)
+	}
+
+	result.Compatible = result.Errors == 0
+
+	return result
+}
+
+func (p *Policy) severity(change Change) Severity {
+	if severity, ok := p.Severities[change.Rule]; ok {
+		return severity
+	}
+
+	if change.Breaking {
+		return SeverityError
+	}
+
+	return SeverityInfo
+}
+
+// IsRule reports whether a rule name is known.
+func IsRule(rule string) bool {
+	for _, known := range Rules() {
+		if known == rule {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Rules returns the names of all rules.
+func Rules() []string {
+	return []string{
+		RuleOperationAdded, RuleOperationRemoved,
+		RuleParameterAdded, RuleRequiredParameterAdded, RuleParameterRemoved,
+		RuleParameterBecameRequired, RuleParameterBecameOptional,
+		RuleRequestBodyAdded, RuleRequiredRequestBodyAdded, RuleRequestBodyRemoved,
+		RuleRequestBodyBecameRequired, RuleRequestBodyBecameOptional,
+		RuleMediaTypeAdded, RuleMediaTypeRemoved,
+		RuleResponseAdded, RuleResponseRemoved, RuleSuccessResponseRemoved,
+		RulePropertyAdded, RuleRequiredRequestPropertyAdded, RuleRequestPropertyRemoved, RuleResponsePropertyRemoved,
+		RuleTypeChanged, RuleFormatChanged,
+		RuleRequestEnumValueAdded, RuleRequestEnumValueRemoved, RuleResponseEnumValueAdded, RuleResponseEnumValueRemoved,
+		RuleRequestConstraintTightened, RuleRequestConstraintLoosened,
+		RuleResponseConstraintTightened, RuleResponseConstraintLoosened,
+		RuleRequestPropertyBecameRequired, RuleRequestPropertyBecameOptional,
+		RuleResponsePropertyBecameRequired, RuleResponsePropertyBecameOptional,
+	}
+}
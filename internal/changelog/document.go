@@ -0,0 +1,63 @@
+package changelog
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/GabrielNunesIT/openapi-converter/internal/domain"
+)
+
+// Document returns a document describing the report, so any converter can
+// render it as a changelog. Changes are listed in the description, breaking
+// changes first, grouped by operation.
+func (r *Report) Document() *domain.OpenAPIDocument {
+	var desc strings.Builder
+
+	breaking := r.Breaking()
+
+	fmt.Fprintf(&desc, "Changes from version %s to version %s.\n\n", r.BaseVersion, r.RevisionVersion)
+
+	if len(r.Changes) == 0 {
+		desc.WriteString("No changes to the API.\n")
+	} else {
+		fmt.Fprintf(&desc, "**%d breaking** and %d non-breaking changes.\n", len(breaking), len(r.Changes)-len(breaking))
+	}
+
+	writeChangeSection(&desc, "Breaking changes", breaking)
+
+	var other []Change
+	for _, change := range r.Changes {
+		if !change.Breaking {
+			other = append(other, change)
+		}
+	}
+	writeChangeSection(&desc, "Other changes", other)
+
+	return &domain.OpenAPIDocument{
+		Title:           r.Title + " Changelog",
+		Version:         fmt.Sprintf("%s to %s", r.BaseVersion, r.RevisionVersion),
+		Description:     desc.String(),
+		Components:      make(map[string]domain.Schema),
+		SecuritySchemes: make(map[string]domain.SecurityScheme),
+	}
+}
+
+// writeChangeSection lists changes under a heading, one sub-heading per
+// operation in the order the changes were found.
+func writeChangeSection(b *strings.Builder, title string, changes []Change) {
+	if len(changes) == 0 {
+		return
+	}
+
+	fmt.Fprintf(b, "\n## %s\n", title)
+
+	operation := ""
+	for _, change := range changes {
+		if change.Operation != operation {
+			operation = change.Operation
+			fmt.Fprintf(b, "\n### `%s`\n\n", operation)
+		}
+
+		fmt.Fprintf(b, "- %s\n", change.Message)
+	}
+}
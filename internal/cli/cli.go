@@ -2,31 +2,58 @@
 package cli
 
 import (
+	"context"
 	"fmt"
+	"io"
 	"os"
-	"path/filepath"
+	"os/signal"
 	"strings"
 
 	"github.com/GabrielNunesIT/go-libs/logger"
-	"github.com/GabrielNunesIT/openapi-converter/internal/adapters/converters"
+	"github.com/GabrielNunesIT/openapi-converter/internal/config"
 	"github.com/GabrielNunesIT/openapi-converter/internal/domain"
-	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/GabrielNunesIT/openapi-converter/pkg/openapiconverter"
 	"github.com/spf13/cobra"
 )
 
+const (
+	defaultMergedTitle = "API Reference"
+	defaultFormat      = "pdf"
+)
+
 // CLI holds the command-line interface configuration.
 type CLI struct {
 	log        logger.ILogger
 	rootCmd    *cobra.Command
-	inputFile  string
+	formats    *openapiconverter.Registry
+	loader     domain.SpecLoader
+	configFile string
+	cfg        *config.Config
+	inputFiles []string
 	outputFile string
 	format     string
+
+	pageSize    string
+	orientation string
+	margins     []float64
+
+	generateExamples bool
+	referenceDoc     string
+	templates        []string
+	templateEntry    string
+	split            bool
+
+	// Merged document settings, used when several inputs are given
+	title      string
+	apiVersion string
 }
 
 // New creates a new CLI instance.
 func New(log logger.ILogger) *CLI {
 	cli := &CLI{
-		log: log,
+		log:     log,
+		formats: openapiconverter.DefaultRegistry(),
+		loader:  openapiconverter.NewLoader(),
 	}
 
 	cli.rootCmd = &cobra.Command{
@@ -34,37 +61,82 @@ func New(log logger.ILogger) *CLI {
 		Short: "Convert OpenAPI specifications to PDF or Word documents",
 		Long:  "A CLI tool that converts OpenAPI 3.x specifications to various document formats including PDF and Word (DOCX).",
 		RunE:  cli.run,
+
+		PersistentPreRunE: func(_ *cobra.Command, _ []string) error {
+			return cli.loadConfig()
+		},
 	}
 
 	cli.setupFlags()
+	cli.rootCmd.AddCommand(cli.newPublishCommand(), cli.newDiffCommand(), cli.newCheckCompatCommand(),
+		cli.newFormatsCommand(), cli.newServeCommand())
 
 	return cli
 }
 
 func (c *CLI) setupFlags() {
-	c.rootCmd.Flags().StringVarP(&c.inputFile, "input", "i", "", "Path to the OpenAPI specification file (required)")
+	c.rootCmd.PersistentFlags().StringVar(&c.configFile, "config", "", "Path to a YAML or JSON configuration file")
+
+	c.rootCmd.Flags().StringSliceVarP(&c.inputFiles, "input", "i", nil,
+		"OpenAPI specification file, URL or - for stdin (required); repeat to merge several specs into one document")
 	c.rootCmd.Flags().StringVarP(&c.outputFile, "output", "o", "", "Path for the output file (required)")
-	c.rootCmd.Flags().StringVarP(&c.format, "format", "f", "pdf", "Output format: pdf, docx")
+	c.rootCmd.Flags().StringVarP(&c.format, "format", "f", "", c.formatFlagUsage("Output"))
+	c.rootCmd.Flags().StringVar(&c.pageSize, "page-size", openapiconverter.PDFPageA4, "PDF page size: A3, A4, A5, Letter, Legal")
+	c.rootCmd.Flags().StringVar(&c.orientation, "orientation", openapiconverter.PDFPortrait, "PDF page orientation: portrait, landscape")
+	c.rootCmd.Flags().Float64SliceVar(&c.margins, "margins", nil,
+		"PDF margins in mm: one value for all sides or four values (top,right,bottom,left)")
+
+	c.rootCmd.Flags().BoolVar(&c.generateExamples, "generate-examples", false,
+		"Generate examples from schemas for request and response bodies that have none")
+
+	c.rootCmd.Flags().StringVar(&c.referenceDoc, "reference-doc", "",
+		"DOCX: .docx or .dotx document whose styles, headers, footers and cover page are reused")
+
+	c.rootCmd.Flags().StringSliceVar(&c.templates, "template", nil,
+		"Template format: Go template file or glob; repeat to add partials. Implies --format template")
+	c.rootCmd.Flags().StringVar(&c.templateEntry, "template-entry", "",
+		"Template format: name of the template to execute (default: the first template file)")
+
+	c.rootCmd.Flags().StringVar(&c.title, "title", "",
+		"Title of the merged document when several inputs are given (default \""+defaultMergedTitle+"\")")
+	c.rootCmd.Flags().StringVar(&c.apiVersion, "api-version", "",
+		"Version of the merged document when several inputs are given (default: the version the specs share)")
+
+	c.rootCmd.Flags().BoolVar(&c.split, "split", false,
+		"Write one document per tag and an index page into the output directory given by --output")
 
 	_ = c.rootCmd.MarkFlagRequired("input")
 	_ = c.rootCmd.MarkFlagRequired("output")
 }
 
-// Execute runs the CLI.
-func (c *CLI) Execute() error {
-	return c.rootCmd.Execute()
+// loadConfig loads the configuration and registers the plugin formats it
+// and PATH provide.
+func (c *CLI) loadConfig() error {
+	cfg, err := config.Load(c.configFile)
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+	c.cfg = cfg
+
+	c.registerPlugins(cfg.Plugins)
+
+	return nil
 }
 
-func (c *CLI) run(_ *cobra.Command, _ []string) error {
-	c.log.Infof("Loading OpenAPI specification from: %s", c.inputFile)
+// Execute runs the CLI. An interrupt cancels the running command.
+func (c *CLI) Execute() error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
 
-	doc, err := c.loadOpenAPI(c.inputFile)
+	return c.rootCmd.ExecuteContext(ctx)
+}
+
+func (c *CLI) run(cmd *cobra.Command, _ []string) error {
+	doc, err := c.loadInputs(cmd.Context(), c.inputFiles, c.title, c.apiVersion)
 	if err != nil {
-		return fmt.Errorf("failed to load OpenAPI specification: %w", err)
+		return err
 	}
 
-	c.log.Infof("Loaded API: %s (v%s)", doc.Title, doc.Version)
-
 	converter, err := c.getConverter()
 	if err != nil {
 		return err
@@ -72,14 +144,18 @@ func (c *CLI) run(_ *cobra.Command, _ []string) error {
 
 	c.log.Infof("Converting to %s format...", converter.Format())
 
+	if c.split {
+		return c.runSplit(cmd.Context(), doc, converter)
+	}
+
 	outputFile, err := os.Create(c.outputFile)
 	if err != nil {
 		return fmt.Errorf("failed to create output file: %w", err)
 	}
 	defer outputFile.Close()
 
-	if err := converter.Convert(doc, outputFile); err != nil {
-		return fmt.Errorf("conversion failed: %w", err)
+	if err := c.convert(cmd.Context(), converter, doc, outputFile); err != nil {
+		return err
 	}
 
 	c.log.Infof("Successfully created: %s", c.outputFile)
@@ -87,239 +163,135 @@ func (c *CLI) run(_ *cobra.Command, _ []string) error {
 	return nil
 }
 
-func (c *CLI) getConverter() (domain.Converter, error) {
-	format := strings.ToLower(c.format)
-
-	switch format {
-	case "pdf":
-		return converters.NewPDFConverter(), nil
-	case "docx", "word":
-		return converters.NewDocxConverter(), nil
-	case "confluence", "adf":
-		return converters.NewADFConverter(), nil
-	default:
-		return nil, fmt.Errorf("unsupported format: %s (supported: pdf, docx, confluence)", c.format)
+// convert runs a converter, drawing its progress when stderr is a terminal
+// and logging the warnings it returns.
+func (c *CLI) convert(ctx context.Context, converter domain.Converter, doc *domain.OpenAPIDocument, w io.Writer) error {
+	var opts domain.ConvertOptions
+
+	bar := newProgressBar(os.Stderr)
+	if bar != nil {
+		opts.Progress = bar.update
 	}
-}
 
-func (c *CLI) loadOpenAPI(path string) (*domain.OpenAPIDocument, error) {
-	loader := openapi3.NewLoader()
-	loader.IsExternalRefsAllowed = true
+	warnings, err := openapiconverter.ConvertWith(ctx, converter, doc, w, opts)
+	bar.clear()
 
-	absPath, err := filepath.Abs(path)
-	if err != nil {
-		return nil, fmt.Errorf("failed to resolve path: %w", err)
+	for _, warning := range warnings {
+		c.log.Warningf("%s: %s", warning.Location, warning.Message)
 	}
 
-	spec, err := loader.LoadFromFile(absPath)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse OpenAPI file: %w", err)
+		return fmt.Errorf("conversion failed: %w", err)
 	}
 
-	return c.convertSpec(spec), nil
+	return nil
 }
 
-func (c *CLI) convertSpec(spec *openapi3.T) *domain.OpenAPIDocument {
-	doc := &domain.OpenAPIDocument{
-		Title:       spec.Info.Title,
-		Version:     spec.Info.Version,
-		Description: spec.Info.Description,
-		Components:      make(map[string]domain.Schema),
-		SecuritySchemes: make(map[string]domain.SecurityScheme),
-	}
-
-	// Convert security schemes
-	if spec.Components != nil && spec.Components.SecuritySchemes != nil {
-		for name, ref := range spec.Components.SecuritySchemes {
-			if ref.Value != nil {
-				doc.SecuritySchemes[name] = domain.SecurityScheme{
-					Type:        ref.Value.Type,
-					Name:        ref.Value.Name,
-					Description: ref.Value.Description,
-					In:          ref.Value.In,
-					Scheme:      ref.Value.Scheme,
-				}
-			}
-		}
-	}
-
-	// Convert global security
-	for _, securityReq := range spec.Security {
-		sec := make(map[string][]string)
-		for name, scopes := range securityReq {
-			sec[name] = scopes
+// getConverter creates the converter of the --format flag. When the flag is
+// not set, the format is the template format if templates are given, or else
+// inferred from the extension of the output file.
+func (c *CLI) getConverter() (domain.Converter, error) {
+	format := c.format
+	if format == "" {
+		format = defaultFormat
+		if len(c.templates) > 0 {
+			format = openapiconverter.TemplateFormat
+		} else if inferred, ok := c.formats.ForFile(c.outputFile); ok {
+			format = inferred.Name
 		}
-		doc.Security = append(doc.Security, sec)
 	}
 
-	// Convert servers
-	for _, server := range spec.Servers {
-		doc.Servers = append(doc.Servers, domain.Server{
-			URL:         server.URL,
-			Description: server.Description,
-		})
+	settings := openapiconverter.Settings{
+		GenerateExamples: c.generateExamples,
+		PageSize:         c.pageSize,
+		Orientation:      c.orientation,
+		ReferenceDoc:     c.referenceDoc,
+		TemplateFiles:    c.templates,
+		TemplateEntry:    c.templateEntry,
 	}
 
-	// Convert tags
-	for _, tag := range spec.Tags {
-		if tag != nil {
-			doc.Tags = append(doc.Tags, domain.Tag{
-				Name:        tag.Name,
-				Description: tag.Description,
-			})
+	if len(c.margins) > 0 {
+		margins, err := parseMargins(c.margins)
+		if err != nil {
+			return nil, err
 		}
-	}
-
-	// Convert paths
-	for pathStr, pathItem := range spec.Paths.Map() {
-		path := domain.Path{Path: pathStr}
 
-		path.Operations = c.convertOperations(pathItem)
-		doc.Paths = append(doc.Paths, path)
+		settings.Margins = &margins
 	}
 
-	// Convert components/schemas
-	if spec.Components != nil && spec.Components.Schemas != nil {
-		for name, schemaRef := range spec.Components.Schemas {
-			doc.Components[name] = c.convertSchema(schemaRef)
-		}
-	}
+	return c.formats.New(format, settings)
+}
 
-	return doc
+// formatFlagUsage describes the --format flag of commands writing documents.
+func (c *CLI) formatFlagUsage(what string) string {
+	return fmt.Sprintf("%s format: %s (default: inferred from the --output extension, else %s)",
+		what, strings.Join(c.formats.Names(), ", "), defaultFormat)
 }
 
-func (c *CLI) convertOperations(pathItem *openapi3.PathItem) []domain.Operation {
-	var operations []domain.Operation
-
-	methods := map[string]*openapi3.Operation{
-		"GET":     pathItem.Get,
-		"POST":    pathItem.Post,
-		"PUT":     pathItem.Put,
-		"DELETE":  pathItem.Delete,
-		"PATCH":   pathItem.Patch,
-		"HEAD":    pathItem.Head,
-		"OPTIONS": pathItem.Options,
+// parseMargins expands the --margins flag values into PDF margins.
+func parseMargins(values []float64) (openapiconverter.PDFMargins, error) {
+	switch len(values) {
+	case 1:
+		return openapiconverter.PDFMargins{Top: values[0], Right: values[0], Bottom: values[0], Left: values[0]}, nil
+	case 4:
+		return openapiconverter.PDFMargins{Top: values[0], Right: values[1], Bottom: values[2], Left: values[3]}, nil
+	default:
+		return openapiconverter.PDFMargins{}, fmt.Errorf("invalid margins: expected 1 or 4 values, got %d", len(values))
 	}
+}
 
-	for method, op := range methods {
-		if op == nil {
-			continue
-		}
-
-		operation := domain.Operation{
-			Method:      method,
-			Summary:     op.Summary,
-			Description: op.Description,
-			OperationID: op.OperationID,
-			Tags:        op.Tags,
-		}
-
-		// Convert parameters
-		for _, param := range op.Parameters {
-			if param.Value == nil {
-				continue
-			}
-
-			operation.Parameters = append(operation.Parameters, domain.Parameter{
-				Name:        param.Value.Name,
-				In:          param.Value.In,
-				Description: param.Value.Description,
-				Required:    param.Value.Required,
-				Schema:      c.convertSchema(param.Value.Schema),
-			})
-		}
-
-		// Convert responses
-		if op.Responses != nil {
-			for statusCode, response := range op.Responses.Map() {
-				if response.Value == nil {
-					continue
-				}
-
-				resp := domain.Response{
-					StatusCode: statusCode,
-				}
-
-				if response.Value.Description != nil {
-					resp.Description = *response.Value.Description
-				}
+// loadInputs loads the input specifications, merging them into a single
+// document with the given title and version when there are several.
+func (c *CLI) loadInputs(ctx context.Context, inputFiles []string, title, apiVersion string,
+) (*domain.OpenAPIDocument, error) {
+	docs := make([]*domain.OpenAPIDocument, 0, len(inputFiles))
 
-				resp.Content = c.convertContent(response.Value.Content)
-				operation.Responses = append(operation.Responses, resp)
-			}
-		}
+	for _, inputFile := range inputFiles {
+		c.log.Infof("Loading OpenAPI specification from: %s", inputFile)
 
-		// Convert request body
-		if op.RequestBody != nil && op.RequestBody.Value != nil {
-			operation.RequestBody = &domain.RequestBody{
-				Description: op.RequestBody.Value.Description,
-				Required:    op.RequestBody.Value.Required,
-				Content:     c.convertContent(op.RequestBody.Value.Content),
-			}
+		doc, err := c.loadOpenAPI(ctx, inputFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load OpenAPI specification %s: %w", inputFile, err)
 		}
 
-		operations = append(operations, operation)
+		c.log.Infof("Loaded API: %s (v%s)", doc.Title, doc.Version)
+		docs = append(docs, doc)
 	}
 
-	return operations
-}
-
-func (c *CLI) convertContent(content openapi3.Content) map[string]domain.MediaType {
-	result := make(map[string]domain.MediaType)
-
-	for mediaType, item := range content {
-		mt := domain.MediaType{
-			Schema:  c.convertSchema(item.Schema),
-			Example: item.Example,
-		}
+	if len(docs) == 1 {
+		return docs[0], nil
+	}
 
-		if len(item.Examples) > 0 {
-			mt.Examples = make(map[string]interface{})
-			for name, ex := range item.Examples {
-				if ex.Value != nil {
-					mt.Examples[name] = ex.Value.Value
-				}
-			}
-		}
+	if title == "" {
+		title = defaultMergedTitle
+	}
 
-		result[mediaType] = mt
+	merged, err := openapiconverter.Merge(title, apiVersion, docs...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to merge specifications: %w", err)
 	}
 
-	return result
-}
+	c.log.Infof("Merged %d specifications into: %s", len(docs), merged.Title)
 
-func (c *CLI) convertSchema(ref *openapi3.SchemaRef) domain.Schema {
-	if ref == nil {
-		return domain.Schema{}
-	}
+	return merged, nil
+}
 
-	schema := domain.Schema{
-		Ref: ref.Ref,
+// loadOpenAPI loads a specification from a file, URL or standard input,
+// logging what the document cannot represent.
+func (c *CLI) loadOpenAPI(ctx context.Context, source string) (*domain.OpenAPIDocument, error) {
+	doc, warnings, err := c.loader.Load(ctx, source)
+	if err != nil {
+		return nil, err
 	}
 
-	if ref.Value != nil {
-		types := ref.Value.Type.Slice()
-		if len(types) > 0 {
-			schema.Type = types[0]
-		}
-		schema.Format = ref.Value.Format
-		schema.Description = ref.Value.Description
-
-		// Convert properties
-		if len(ref.Value.Properties) > 0 {
-			schema.Properties = make(map[string]domain.Schema)
+	c.logLoadWarnings(source, warnings)
 
-			for name, prop := range ref.Value.Properties {
-				schema.Properties[name] = c.convertSchema(prop)
-			}
-		}
+	return doc, nil
+}
 
-		// Convert items for arrays
-		if ref.Value.Items != nil {
-			itemSchema := c.convertSchema(ref.Value.Items)
-			schema.Items = &itemSchema
-		}
+// logLoadWarnings logs what the document loaded from source cannot represent.
+func (c *CLI) logLoadWarnings(source string, warnings []domain.Warning) {
+	for _, warning := range warnings {
+		c.log.Warningf("%s: %s: %s", source, warning.Location, warning.Message)
 	}
-
-	return schema
 }
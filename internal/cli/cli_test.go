@@ -0,0 +1,235 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/GabrielNunesIT/go-libs/logger"
+	"github.com/GabrielNunesIT/openapi-converter/internal/config"
+	"github.com/GabrielNunesIT/openapi-converter/internal/domain"
+)
+
+// loaded is what fakeLoader returns for a source.
+type loaded struct {
+	doc      *domain.OpenAPIDocument
+	warnings []domain.Warning
+	err      error
+}
+
+// fakeLoader loads the documents it holds by source, or by location for
+// LoadData, and records the data it is given.
+type fakeLoader struct {
+	sources map[string]loaded
+	data    []byte
+}
+
+func (l *fakeLoader) Load(_ context.Context, source string) (*domain.OpenAPIDocument, []domain.Warning, error) {
+	result, ok := l.sources[source]
+	if !ok {
+		return nil, nil, fmt.Errorf("unexpected source %s", source)
+	}
+
+	return result.doc, result.warnings, result.err
+}
+
+func (l *fakeLoader) LoadData(data []byte, location string) (*domain.OpenAPIDocument, []domain.Warning, error) {
+	l.data = data
+
+	return l.Load(context.Background(), location)
+}
+
+func TestLoadInputs(t *testing.T) {
+	pets := &domain.OpenAPIDocument{Title: "Pets", Version: "1", Paths: []domain.Path{{Path: "/pets"}}}
+	store := &domain.OpenAPIDocument{Title: "Store", Version: "1", Paths: []domain.Path{{Path: "/orders"}}}
+	warning := domain.Warning{Location: "GET /pets", Message: "Callbacks are not rendered"}
+
+	tests := []struct {
+		name    string
+		inputs  []string
+		title   string
+		paths   int
+		logged  string
+		wantErr string
+	}{
+		{name: "single input", inputs: []string{"pets.yaml"}, title: "Pets", paths: 1, logged: "pets.yaml: GET /pets"},
+		{name: "merged inputs", inputs: []string{"pets.yaml", "store.yaml"}, title: defaultMergedTitle, paths: 2},
+		{name: "load error", inputs: []string{"pets.yaml", "broken.yaml"}, wantErr: "broken.yaml: invalid"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var logs bytes.Buffer
+			c := New(logger.NewConsoleLogger(&logs))
+			c.loader = &fakeLoader{sources: map[string]loaded{
+				"pets.yaml":   {doc: pets, warnings: []domain.Warning{warning}},
+				"store.yaml":  {doc: store},
+				"broken.yaml": {err: errors.New("invalid")},
+			}}
+
+			doc, err := c.loadInputs(context.Background(), tt.inputs, "", "")
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("loadInputs() error = %v, want it to contain %q", err, tt.wantErr)
+				}
+
+				return
+			}
+			if err != nil {
+				t.Fatalf("loadInputs() error = %v", err)
+			}
+
+			if doc.Title != tt.title || len(doc.Paths) != tt.paths {
+				t.Errorf("document = %q with %d paths, want %q with %d", doc.Title, len(doc.Paths), tt.title, tt.paths)
+			}
+			if !strings.Contains(logs.String(), tt.logged) {
+				t.Errorf("logs = %q, want them to contain %q", logs.String(), tt.logged)
+			}
+		})
+	}
+}
+
+func TestLoadGitRevision(t *testing.T) {
+	dir := t.TempDir()
+	spec := filepath.Join(dir, "api.yaml")
+
+	git := func(args ...string) {
+		t.Helper()
+
+		cmd := exec.Command("git", append([]string{"-c", "user.name=test", "-c", "user.email=test@example.com"}, args...)...)
+		cmd.Dir = dir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v failed: %v: %s", args, err, out)
+		}
+	}
+
+	git("init", "-q")
+	if err := os.WriteFile(spec, []byte("committed"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	git("add", "api.yaml")
+	git("commit", "-q", "-m", "add spec")
+	if err := os.WriteFile(spec, []byte("working tree"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		rev     string
+		wantErr string
+	}{
+		{name: "revision", ctx: context.Background(), rev: "HEAD"},
+		{name: "unknown revision", ctx: context.Background(), rev: "v9.9.9", wantErr: "git show failed"},
+		{name: "cancelled", ctx: cancelled, rev: "HEAD", wantErr: "git show failed"},
+		{name: "option as revision", ctx: context.Background(), rev: "--output=" + spec, wantErr: "invalid revision"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc := &domain.OpenAPIDocument{Title: "Pets"}
+			warning := domain.Warning{Location: "servers", Message: "Variables are not rendered"}
+
+			loader := &fakeLoader{sources: map[string]loaded{
+				spec: {doc: doc, warnings: []domain.Warning{warning}},
+			}}
+
+			var logs bytes.Buffer
+			c := New(logger.NewConsoleLogger(&logs))
+			c.loader = loader
+
+			got, err := c.loadGitRevision(tt.ctx, tt.rev, spec)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("loadGitRevision() error = %v, want it to contain %q", err, tt.wantErr)
+				}
+
+				return
+			}
+			if err != nil {
+				t.Fatalf("loadGitRevision() error = %v", err)
+			}
+
+			if got != doc || string(loader.data) != "committed" {
+				t.Errorf("loadGitRevision() = %+v from %q, want the document loaded from the revision", got, loader.data)
+			}
+			if want := "HEAD:" + spec + ": servers"; !strings.Contains(logs.String(), want) {
+				t.Errorf("logs = %q, want them to contain %q", logs.String(), want)
+			}
+		})
+	}
+}
+
+func TestRunCheckCompat(t *testing.T) {
+	const spec = `openapi: 3.0.3
+info:
+  title: Pets API
+  version: %s
+paths:
+  /pets:
+    post:
+      requestBody:
+        required: %t
+        content:
+          application/json:
+            schema:
+              type: object
+      responses:
+        "201":
+          description: Added
+`
+
+	dir := t.TempDir()
+	write := func(name, version string, required bool) string {
+		t.Helper()
+
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(fmt.Sprintf(spec, version, required)), 0o600); err != nil {
+			t.Fatal(err)
+		}
+
+		return path
+	}
+
+	optional := write("optional.yaml", "1.0.0", false)
+	required := write("required.yaml", "2.0.0", true)
+
+	tests := []struct {
+		name     string
+		base     string
+		revision string
+		wantErr  string
+	}{
+		{name: "request body became required", base: optional, revision: required, wantErr: "found 1 breaking changes"},
+		{name: "request body became optional", base: required, revision: optional},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := New(logger.NewConsoleLogger(io.Discard))
+			c.cfg = &config.Config{}
+
+			err := c.runCheckCompat(context.Background(), tt.revision, &compatOptions{baseFile: tt.base})
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("runCheckCompat() error = %v, want the revision to be compatible", err)
+				}
+
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("runCheckCompat() error = %v, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
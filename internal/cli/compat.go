@@ -0,0 +1,204 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/GabrielNunesIT/openapi-converter/internal/changelog"
+	"github.com/GabrielNunesIT/openapi-converter/internal/config"
+	"github.com/GabrielNunesIT/openapi-converter/internal/domain"
+	"github.com/spf13/cobra"
+)
+
+// compatOptions holds the flags of the check-compat command.
+type compatOptions struct {
+	baseFile   string
+	baseRef    string
+	allowlist  string
+	reportFile string
+	rules      map[string]string
+}
+
+func (c *CLI) newCheckCompatCommand() *cobra.Command {
+	opts := &compatOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "check-compat <spec>",
+		Short: "Fail when a specification breaks compatibility with a base version",
+		Long: "Compare a specification with a base version, read from a file with --base or from a git revision of the\n" +
+			"same file with --base-ref, and exit with an error when it contains breaking changes.\n" +
+			"Rule severities are set in the compat.rules section of --config or with --rule; accepted breaking\n" +
+			"changes are listed in an allowlist file. Rules: " + strings.Join(changelog.Rules(), ", ") + ".",
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return c.runCheckCompat(cmd.Context(), args[0], opts)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&opts.baseFile, "base", "", "Path to the base specification")
+	flags.StringVar(&opts.baseRef, "base-ref", "", "Git revision holding the base version of the specification, e.g. origin/main")
+	flags.StringToStringVar(&opts.rules, "rule", nil,
+		"Severity of a rule: error, warning, info or off, e.g. --rule response-enum-value-added=warning")
+	flags.StringVar(&opts.allowlist, "allowlist", "", "Path to a YAML or JSON file listing accepted breaking changes")
+	flags.StringVar(&opts.reportFile, "report", "", "Path for a JSON report of the check; - writes to stdout")
+
+	cmd.MarkFlagsOneRequired("base", "base-ref")
+	cmd.MarkFlagsMutuallyExclusive("base", "base-ref")
+
+	return cmd
+}
+
+func (c *CLI) runCheckCompat(ctx context.Context, specPath string, opts *compatOptions) error {
+	policy, err := compatPolicy(c.cfg.Compat, opts)
+	if err != nil {
+		return err
+	}
+
+	base, err := c.loadBase(ctx, specPath, opts)
+	if err != nil {
+		return err
+	}
+
+	revision, err := c.loadOpenAPI(ctx, specPath)
+	if err != nil {
+		return fmt.Errorf("failed to load OpenAPI specification %s: %w", specPath, err)
+	}
+
+	result := policy.Check(changelog.Compare(base, revision))
+
+	for _, finding := range result.Findings {
+		switch {
+		case finding.Severity == changelog.SeverityInfo:
+		case finding.Allowed:
+			c.log.Infof("[allowed] %s: %s (%s)", finding.Operation, finding.Message, finding.Rule)
+		case finding.Severity == changelog.SeverityError:
+			c.log.Errorf("%s: %s (%s)", finding.Operation, finding.Message, finding.Rule)
+		case finding.Severity == changelog.SeverityWarning:
+			c.log.Warningf("%s: %s (%s)", finding.Operation, finding.Message, finding.Rule)
+		}
+	}
+
+	if opts.reportFile != "" {
+		if err := writeReport(result, opts.reportFile); err != nil {
+			return err
+		}
+	}
+
+	if !result.Compatible {
+		return fmt.Errorf("found %d breaking changes from version %s", result.Errors, result.BaseVersion)
+	}
+
+	c.log.Infof("Compatible with version %s (%d warnings)", result.BaseVersion, result.Warnings)
+
+	return nil
+}
+
+// compatPolicy builds the check policy from the configuration, overridden
+// by the flags.
+func compatPolicy(cfg config.CompatConfig, opts *compatOptions) (*changelog.Policy, error) {
+	rules := make(map[string]string, len(cfg.Rules)+len(opts.rules))
+	for rule, severity := range cfg.Rules {
+		rules[rule] = severity
+	}
+	for rule, severity := range opts.rules {
+		rules[rule] = severity
+	}
+
+	policy := &changelog.Policy{Severities: make(map[string]changelog.Severity, len(rules))}
+
+	names := make([]string, 0, len(rules))
+	for rule := range rules {
+		names = append(names, rule)
+	}
+	sort.Strings(names)
+
+	for _, rule := range names {
+		if !changelog.IsRule(rule) {
+			return nil, fmt.Errorf("unknown rule %q", rule)
+		}
+
+		severity, err := changelog.ParseSeverity(rules[rule])
+		if err != nil {
+			return nil, fmt.Errorf("rule %s: %w", rule, err)
+		}
+		policy.Severities[rule] = severity
+	}
+
+	allowlist := cfg.Allowlist
+	if opts.allowlist != "" {
+		allowlist = opts.allowlist
+	}
+
+	if allowlist != "" {
+		allowed, err := changelog.LoadAllowlist(allowlist)
+		if err != nil {
+			return nil, err
+		}
+		policy.Allowlist = allowed
+	}
+
+	return policy, nil
+}
+
+// loadBase loads the base specification from its file or git revision.
+func (c *CLI) loadBase(ctx context.Context, specPath string, opts *compatOptions) (*domain.OpenAPIDocument, error) {
+	if opts.baseFile != "" {
+		doc, err := c.loadOpenAPI(ctx, opts.baseFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load base specification %s: %w", opts.baseFile, err)
+		}
+
+		return doc, nil
+	}
+
+	doc, err := c.loadGitRevision(ctx, opts.baseRef, specPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load %s at %s: %w", specPath, opts.baseRef, err)
+	}
+
+	return doc, nil
+}
+
+// loadGitRevision loads a specification as it was at a git revision.
+// Relative references resolve against the working tree.
+func (c *CLI) loadGitRevision(ctx context.Context, rev, path string) (*domain.OpenAPIDocument, error) {
+	// git would read such a revision as an option
+	if strings.HasPrefix(rev, "-") {
+		return nil, fmt.Errorf("invalid revision %q", rev)
+	}
+
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve path: %w", err)
+	}
+
+	//nolint:gosec // Runs git with a user-given revision
+	cmd := exec.CommandContext(ctx, "git", "show", rev+":./"+filepath.Base(absPath))
+	cmd.Dir = filepath.Dir(absPath)
+
+	data, err := cmd.Output()
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && len(exitErr.Stderr) > 0 {
+			return nil, fmt.Errorf("git show failed: %s", strings.TrimSpace(string(exitErr.Stderr)))
+		}
+
+		return nil, fmt.Errorf("git show failed: %w", err)
+	}
+
+	doc, warnings, err := c.loader.LoadData(data, absPath)
+	if err != nil {
+		return nil, err
+	}
+
+	c.logLoadWarnings(rev+":"+path, warnings)
+
+	return doc, nil
+}
@@ -0,0 +1,102 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/GabrielNunesIT/openapi-converter/internal/changelog"
+	"github.com/spf13/cobra"
+)
+
+// diffOptions holds the flags of the diff command.
+type diffOptions struct {
+	reportFile string
+}
+
+func (c *CLI) newDiffCommand() *cobra.Command {
+	opts := &diffOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "diff <base> <revision>",
+		Short: "Write a changelog between two versions of an OpenAPI specification",
+		Long: "Compare two versions of an OpenAPI specification and list the operations, parameters, responses and\n" +
+			"schema properties that were added, removed or changed, marking the changes that break existing clients.\n" +
+			"The changelog is rendered in any output format with --output, and as JSON with --report.",
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return c.runDiff(cmd.Context(), args[0], args[1], opts)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVarP(&c.outputFile, "output", "o", "", "Path for the changelog document")
+	flags.StringVarP(&c.format, "format", "f", "", c.formatFlagUsage("Changelog"))
+	flags.StringVar(&opts.reportFile, "report", "", "Path for a JSON report of the changes; - writes to stdout")
+
+	return cmd
+}
+
+func (c *CLI) runDiff(ctx context.Context, basePath, revisionPath string, opts *diffOptions) error {
+	if c.outputFile == "" && opts.reportFile == "" {
+		return fmt.Errorf("at least one of --output or --report is required")
+	}
+
+	base, err := c.loadOpenAPI(ctx, basePath)
+	if err != nil {
+		return fmt.Errorf("failed to load OpenAPI specification %s: %w", basePath, err)
+	}
+
+	revision, err := c.loadOpenAPI(ctx, revisionPath)
+	if err != nil {
+		return fmt.Errorf("failed to load OpenAPI specification %s: %w", revisionPath, err)
+	}
+
+	report := changelog.Compare(base, revision)
+	c.log.Infof("Found %d changes, %d breaking", len(report.Changes), len(report.Breaking()))
+
+	if opts.reportFile != "" {
+		if err := writeReport(report, opts.reportFile); err != nil {
+			return err
+		}
+	}
+
+	if c.outputFile == "" {
+		return nil
+	}
+
+	converter, err := c.getConverter()
+	if err != nil {
+		return err
+	}
+
+	if err := c.writeDocument(ctx, converter, report.Document(), c.outputFile); err != nil {
+		return err
+	}
+
+	c.log.Infof("Successfully created: %s", c.outputFile)
+
+	return nil
+}
+
+// writeReport writes a report as indented JSON to a file, or to stdout when
+// the file name is "-".
+func writeReport(report any, fileName string) error {
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode report: %w", err)
+	}
+	data = append(data, '\n')
+
+	if fileName == "-" {
+		_, err = os.Stdout.Write(data)
+	} else {
+		err = os.WriteFile(fileName, data, 0o644)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to write report: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,32 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+)
+
+func (c *CLI) newFormatsCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "formats",
+		Short: "List the supported output formats",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+
+			fmt.Fprintln(w, "FORMAT\tALIASES\tEXTENSION\tMIME TYPE\tDESCRIPTION")
+			for _, format := range c.formats.Formats() {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", format.Name, strings.Join(format.Aliases, ", "),
+					format.Extension, format.MIMEType, format.Description)
+			}
+
+			if err := w.Flush(); err != nil {
+				return fmt.Errorf("failed to write formats: %w", err)
+			}
+
+			return nil
+		},
+	}
+}
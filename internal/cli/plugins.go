@@ -0,0 +1,76 @@
+package cli
+
+import (
+	"sort"
+
+	"github.com/GabrielNunesIT/openapi-converter/internal/adapters/plugins"
+	"github.com/GabrielNunesIT/openapi-converter/internal/config"
+	"github.com/GabrielNunesIT/openapi-converter/internal/domain"
+	"github.com/GabrielNunesIT/openapi-converter/pkg/openapiconverter"
+)
+
+// registerPlugins adds the configured plugins and the plugin executables
+// found in the configured directories and on PATH as output formats.
+// Built-in formats take precedence over plugins of the same name.
+func (c *CLI) registerPlugins(cfg config.PluginsConfig) {
+	discovered := plugins.Discover(cfg.Dirs)
+
+	formats := make(map[string]config.PluginConfig, len(discovered)+len(cfg.Formats))
+	for name, command := range discovered {
+		formats[name] = config.PluginConfig{Command: command}
+	}
+	for name, plugin := range cfg.Formats {
+		if plugin.Command == "" {
+			plugin.Command = discovered[name]
+		}
+		formats[name] = plugin
+	}
+
+	names := make([]string, 0, len(formats))
+	for name := range formats {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		plugin := formats[name]
+		if plugin.Command == "" {
+			c.log.Warningf("Plugin format %s has no command and no %s%s executable was found", name, plugins.ExecutablePrefix, name)
+			continue
+		}
+
+		if _, ok := c.formats.Lookup(name); ok {
+			continue
+		}
+
+		if err := c.formats.Register(pluginFormat(name, plugin)); err != nil {
+			c.log.Warningf("Skipping plugin %s: %v", plugin.Command, err)
+		}
+	}
+}
+
+func pluginFormat(name string, plugin config.PluginConfig) openapiconverter.Format {
+	format := openapiconverter.Format{
+		Name:        name,
+		Extension:   plugin.Extension,
+		MIMEType:    plugin.MIMEType,
+		Description: plugin.Description,
+		Plugin:      true,
+		New: func(s openapiconverter.Settings) domain.Converter {
+			return plugins.NewConverter(name, plugin.Command, plugins.Options{
+				GenerateExamples: s.GenerateExamples,
+				PageSize:         s.PageSize,
+				Orientation:      s.Orientation,
+			})
+		},
+	}
+
+	if format.Extension == "" {
+		format.Extension = "." + name
+	}
+	if format.Description == "" {
+		format.Description = "Plugin " + plugin.Command
+	}
+
+	return format
+}
@@ -0,0 +1,73 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/GabrielNunesIT/openapi-converter/internal/domain"
+	"github.com/mattn/go-isatty"
+)
+
+const (
+	progressBarWidth  = 30
+	progressItemWidth = 50
+)
+
+var spinnerFrames = []string{"|", "/", "-", `\`}
+
+// progressBar draws the progress of a conversion on a single terminal line:
+// a bar while the number of endpoints is known, or else a spinner.
+type progressBar struct {
+	out   io.Writer
+	frame int
+	drawn bool
+}
+
+// newProgressBar returns a progress bar drawing on f, or nil when f is not a
+// terminal.
+func newProgressBar(f *os.File) *progressBar {
+	if !isatty.IsTerminal(f.Fd()) && !isatty.IsCygwinTerminal(f.Fd()) {
+		return nil
+	}
+
+	return &progressBar{out: f}
+}
+
+// update redraws the line for a progress event.
+func (b *progressBar) update(p domain.Progress) {
+	item := p.Section
+	if p.Endpoint != "" {
+		item = p.Endpoint
+	}
+	if len(item) > progressItemWidth {
+		item = item[:progressItemWidth-3] + "..."
+	}
+
+	var line string
+	if p.Total > 0 {
+		// Converters may report more endpoints done than the total
+		filled := min(max(p.Done*progressBarWidth/p.Total, 0), progressBarWidth)
+		line = fmt.Sprintf("[%s%s] %d/%d %s",
+			strings.Repeat("=", filled), strings.Repeat(" ", progressBarWidth-filled), p.Done, p.Total, item)
+	} else {
+		// No total to draw a bar against
+		line = fmt.Sprintf("%s %s", spinnerFrames[b.frame%len(spinnerFrames)], item)
+		b.frame++
+	}
+
+	fmt.Fprintf(b.out, "\r\033[K%s", line)
+	b.drawn = true
+}
+
+// clear erases the line, so later output starts on a clean line. It does
+// nothing on a nil bar.
+func (b *progressBar) clear() {
+	if b == nil || !b.drawn {
+		return
+	}
+
+	fmt.Fprint(b.out, "\r\033[K")
+	b.drawn = false
+}
@@ -0,0 +1,43 @@
+package cli
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/GabrielNunesIT/openapi-converter/internal/domain"
+)
+
+func TestProgressBarUpdate(t *testing.T) {
+	full := "[" + strings.Repeat("=", progressBarWidth) + "]"
+	empty := "[" + strings.Repeat(" ", progressBarWidth) + "]"
+
+	tests := []struct {
+		name     string
+		progress domain.Progress
+		want     string
+	}{
+		{name: "started", progress: domain.Progress{Endpoint: "GET /pets", Total: 2}, want: empty + " 0/2 GET /pets"},
+		{name: "done", progress: domain.Progress{Endpoint: "GET /pets", Done: 2, Total: 2}, want: full + " 2/2 GET /pets"},
+		{
+			name:     "beyond the total",
+			progress: domain.Progress{Section: "Schemas", Done: 3, Total: 2},
+			want:     full + " 3/2 Schemas",
+		},
+		{name: "negative", progress: domain.Progress{Section: "Schemas", Done: -1, Total: 2}, want: empty + " -1/2 Schemas"},
+		{name: "no total", progress: domain.Progress{Section: "Schemas"}, want: "| Schemas"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			bar := &progressBar{out: &out}
+
+			bar.update(tt.progress)
+
+			if got := strings.TrimPrefix(out.String(), "\r\033[K"); got != tt.want {
+				t.Errorf("update() drew %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,173 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/GabrielNunesIT/openapi-converter/internal/adapters/confluence"
+	"github.com/GabrielNunesIT/openapi-converter/internal/config"
+	"github.com/GabrielNunesIT/openapi-converter/internal/domain"
+	"github.com/GabrielNunesIT/openapi-converter/pkg/openapiconverter"
+	"github.com/spf13/cobra"
+)
+
+// publishOptions holds the flags of the publish commands.
+type publishOptions struct {
+	inputFiles       []string
+	title            string
+	apiVersion       string
+	pagePerTag       bool
+	representation   string
+	generateExamples bool
+
+	confluence config.ConfluenceConfig
+}
+
+func (c *CLI) newPublishCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "publish",
+		Short: "Publish generated documentation to a documentation platform",
+	}
+
+	cmd.AddCommand(c.newPublishConfluenceCommand())
+
+	return cmd
+}
+
+func (c *CLI) newPublishConfluenceCommand() *cobra.Command {
+	opts := &publishOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "confluence",
+		Short: "Create or update Confluence pages from an OpenAPI specification",
+		Long: "Create or update a Confluence page, and optionally one child page per tag, through the REST API.\n" +
+			"Settings are read from --config and " + config.EnvPrefix + "CONFLUENCE_* environment variables; flags take precedence.\n" +
+			"Pages whose content has not changed since the last publish are not updated.",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return c.runPublishConfluence(cmd.Context(), opts)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringSliceVarP(&opts.inputFiles, "input", "i", nil,
+		"OpenAPI specification file, URL or - for stdin (required); repeat to merge several specs into one page tree")
+	flags.StringVar(&opts.confluence.URL, "url", "", "Confluence base URL, e.g. https://example.atlassian.net/wiki")
+	flags.StringVar(&opts.confluence.Space, "space", "", "Key of the space to publish to")
+	flags.StringVar(&opts.confluence.Parent, "parent", "", "ID of the page to publish under")
+	flags.StringVar(&opts.confluence.User, "user", "", "User name or email; leave empty to send the token as a bearer token")
+	flags.StringVar(&opts.title, "title", "", "Page title (default: the API title, or \""+defaultMergedTitle+"\" when merging)")
+	flags.StringVar(&opts.apiVersion, "api-version", "",
+		"Version of the merged document when several inputs are given (default: the version the specs share)")
+	flags.BoolVar(&opts.pagePerTag, "page-per-tag", false, "Publish endpoints as one child page per tag")
+	flags.StringVar(&opts.representation, "representation", "adf",
+		"Page body format: adf (Confluence Cloud) or storage (Server/Data Center)")
+	flags.BoolVar(&opts.generateExamples, "generate-examples", false,
+		"Generate examples from schemas for request and response bodies that have none")
+
+	_ = cmd.MarkFlagRequired("input")
+
+	return cmd
+}
+
+func (c *CLI) runPublishConfluence(ctx context.Context, opts *publishOptions) error {
+	settings := mergeConfluenceConfig(c.cfg.Confluence, opts.confluence)
+	if settings.URL == "" || settings.Space == "" {
+		return fmt.Errorf("confluence url and space are required")
+	}
+
+	representation, converter, err := publishConverter(opts.representation, opts.generateExamples)
+	if err != nil {
+		return err
+	}
+
+	doc, err := c.loadInputs(ctx, opts.inputFiles, opts.title, opts.apiVersion)
+	if err != nil {
+		return err
+	}
+
+	clientOpts := []confluence.ClientOption{confluence.WithBearerToken(settings.Token)}
+	if settings.User != "" {
+		clientOpts = []confluence.ClientOption{confluence.WithBasicAuth(settings.User, settings.Token)}
+	}
+	publisher := confluence.NewPublisher(confluence.NewClient(settings.URL, clientOpts...), settings.Space, representation)
+
+	title := opts.title
+	if title == "" {
+		title = doc.Title
+	}
+
+	if !opts.pagePerTag {
+		_, err := c.publishPage(ctx, publisher, converter, doc, title, settings.Parent)
+		return err
+	}
+
+	overview := *doc
+	overview.Paths = nil
+
+	root, err := c.publishPage(ctx, publisher, converter, &overview, title, settings.Parent)
+	if err != nil {
+		return err
+	}
+
+	for _, tag := range doc.OperationTags() {
+		if _, err := c.publishPage(ctx, publisher, converter, doc.ForTag(tag), fmt.Sprintf("%s - %s", title, tag), root.ID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// publishConverter returns the content API representation and converter for
+// the --representation flag.
+func publishConverter(representation string, generateExamples bool) (string, domain.Converter, error) {
+	switch representation {
+	case "adf", confluence.RepresentationADF:
+		return confluence.RepresentationADF,
+			openapiconverter.NewADFConverter(openapiconverter.WithADFGeneratedExamples(generateExamples)), nil
+	case confluence.RepresentationStorage:
+		return confluence.RepresentationStorage,
+			openapiconverter.NewConfluenceStorageConverter(
+				openapiconverter.WithConfluenceStorageGeneratedExamples(generateExamples)), nil
+	default:
+		return "", nil, fmt.Errorf("unsupported representation: %s (supported: adf, storage)", representation)
+	}
+}
+
+func (c *CLI) publishPage(ctx context.Context, publisher *confluence.Publisher, converter domain.Converter,
+	doc *domain.OpenAPIDocument, title, parentID string,
+) (*confluence.Result, error) {
+	var buf bytes.Buffer
+	if err := c.convert(ctx, converter, doc, &buf); err != nil {
+		return nil, err
+	}
+
+	result, err := publisher.Publish(ctx, title, parentID, buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to publish %q: %w", title, err)
+	}
+
+	c.log.Infof("Page %q %s (id %s)", result.Title, result.Action, result.ID)
+
+	return result, nil
+}
+
+// mergeConfluenceConfig overrides configured settings with the flags that
+// were set.
+func mergeConfluenceConfig(cfg, flags config.ConfluenceConfig) config.ConfluenceConfig {
+	if flags.URL != "" {
+		cfg.URL = flags.URL
+	}
+	if flags.Space != "" {
+		cfg.Space = flags.Space
+	}
+	if flags.Parent != "" {
+		cfg.Parent = flags.Parent
+	}
+	if flags.User != "" {
+		cfg.User = flags.User
+	}
+
+	return cfg
+}
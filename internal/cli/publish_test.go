@@ -0,0 +1,57 @@
+package cli
+
+import (
+	"context"
+	"io"
+	"slices"
+	"testing"
+
+	"github.com/GabrielNunesIT/go-libs/logger"
+	"github.com/GabrielNunesIT/openapi-converter/internal/adapters/confluence"
+	"github.com/GabrielNunesIT/openapi-converter/internal/adapters/confluence/confluencetest"
+)
+
+func TestPublishConfluencePagePerTag(t *testing.T) {
+	srv := confluencetest.NewServer()
+	defer srv.Close()
+
+	args := []string{
+		"publish", "confluence", "-i", "testdata/petstore.yaml", "--url", srv.URL, "--space", "DOC",
+		"--parent", "100", "--title", "Pets", "--representation", "storage", "--page-per-tag",
+	}
+
+	for run := range 2 {
+		c := New(logger.NewConsoleLogger(io.Discard))
+		c.rootCmd.SetArgs(args)
+		if err := c.rootCmd.ExecuteContext(context.Background()); err != nil {
+			t.Fatalf("run %d: publish failed: %v", run, err)
+		}
+
+		// The flags belong to the publish command, not the root command
+		if c.title != "" || len(c.inputFiles) != 0 {
+			t.Errorf("run %d: publish flags set root fields: title %q, inputs %v", run, c.title, c.inputFiles)
+		}
+	}
+
+	root := srv.Page("Pets")
+	if root == nil {
+		t.Fatal("root page was not created")
+	}
+	if !slices.Equal(root.Ancestors, []confluence.PageRef{{ID: "100"}}) {
+		t.Errorf("root page ancestors = %v, want [100]", root.Ancestors)
+	}
+
+	for _, title := range []string{"Pets - pets", "Pets - store"} {
+		page := srv.Page(title)
+		if page == nil {
+			t.Errorf("page %q was not created", title)
+			continue
+		}
+		if !slices.Equal(page.Ancestors, []confluence.PageRef{{ID: root.ID}}) {
+			t.Errorf("page %q ancestors = %v, want [%s]", title, page.Ancestors, root.ID)
+		}
+		if page.Version.Number != 1 {
+			t.Errorf("page %q version = %d, want 1 after an unchanged second publish", title, page.Version.Number)
+		}
+	}
+}
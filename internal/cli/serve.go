@@ -0,0 +1,116 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/GabrielNunesIT/openapi-converter/internal/adapters/server"
+	"github.com/spf13/cobra"
+)
+
+const (
+	defaultServeAddr = ":8080"
+
+	// serveShutdownTimeout is how long requests in progress may run after an
+	// interrupt.
+	serveShutdownTimeout = 30 * time.Second
+)
+
+// serveOptions holds the flags of the serve command.
+type serveOptions struct {
+	addr          string
+	maxBodyMB     int64
+	timeout       time.Duration
+	maxConcurrent int
+	allowPlugins  bool
+}
+
+func (c *CLI) newServeCommand() *cobra.Command {
+	opts := &serveOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "serve",
+		Short: "Serve conversions over HTTP",
+		Long: "Start an HTTP server converting OpenAPI specifications:\n" +
+			"  POST /convert?format=pdf  convert the JSON or YAML specification in the body\n" +
+			"  GET  /formats             list the output formats\n" +
+			"  GET  /healthz             report that the server is up\n" +
+			"Other /convert parameters are generateExamples, pageSize and orientation.\n" +
+			"External $refs are not followed, the template format is not served and plugin\n" +
+			"formats are only served with --allow-plugins.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return c.runServe(cmd.Context(), opts)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&opts.addr, "addr", defaultServeAddr, "Address to listen on")
+	flags.Int64Var(&opts.maxBodyMB, "max-body-mb", server.DefaultMaxBodySize>>20, "Largest specification accepted, in MiB")
+	flags.DurationVar(&opts.timeout, "timeout", server.DefaultTimeout,
+		"How long a conversion may take, including waiting for a free slot")
+	flags.IntVar(&opts.maxConcurrent, "max-concurrent", server.DefaultMaxConcurrent, "Conversions run at once")
+	flags.BoolVar(&opts.allowPlugins, "allow-plugins", false,
+		"Serve plugin formats, which run the plugin programs with the posted specifications")
+
+	return cmd
+}
+
+func (c *CLI) runServe(ctx context.Context, opts *serveOptions) error {
+	if opts.maxBodyMB <= 0 || opts.timeout <= 0 || opts.maxConcurrent <= 0 {
+		return fmt.Errorf("max-body-mb, timeout and max-concurrent must be positive")
+	}
+
+	httpServer := &http.Server{
+		Addr:              opts.addr,
+		Handler:           c.newServer(opts).Handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+		ReadTimeout:       opts.timeout,
+		// Leaves time to write the document once the conversion is done
+		WriteTimeout: 2 * opts.timeout,
+		BaseContext: func(net.Listener) context.Context {
+			return ctx
+		},
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		c.log.Infof("Listening on %s", opts.addr)
+		errCh <- httpServer.ListenAndServe()
+	}()
+
+	select {
+	case err := <-errCh:
+		return fmt.Errorf("server failed: %w", err)
+	case <-ctx.Done():
+	}
+
+	c.log.Infof("Shutting down")
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), serveShutdownTimeout)
+	defer cancel()
+
+	if err := httpServer.Shutdown(shutdownCtx); err != nil {
+		return fmt.Errorf("failed to shut down server: %w", err)
+	}
+
+	if err := <-errCh; !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("server failed: %w", err)
+	}
+
+	return nil
+}
+
+// newServer creates the conversion server of the serve command.
+func (c *CLI) newServer(opts *serveOptions) *server.Server {
+	return server.New(c.formats, c.log,
+		server.WithMaxBodySize(opts.maxBodyMB<<20),
+		server.WithTimeout(opts.timeout),
+		server.WithMaxConcurrent(opts.maxConcurrent),
+		server.WithPlugins(opts.allowPlugins),
+	)
+}
@@ -0,0 +1,152 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/GabrielNunesIT/go-libs/logger"
+	"github.com/GabrielNunesIT/openapi-converter/internal/adapters/server"
+	"github.com/GabrielNunesIT/openapi-converter/internal/config"
+)
+
+func defaultServeOptions() *serveOptions {
+	return &serveOptions{
+		addr:          "127.0.0.1:0",
+		maxBodyMB:     server.DefaultMaxBodySize >> 20,
+		timeout:       server.DefaultTimeout,
+		maxConcurrent: server.DefaultMaxConcurrent,
+	}
+}
+
+func TestRunServe(t *testing.T) {
+	// A free port, released for the server to listen on
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to find a free port: %v", err)
+	}
+	addr := listener.Addr().String()
+	listener.Close()
+
+	opts := defaultServeOptions()
+	opts.addr = addr
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- New(logger.NewConsoleLogger(io.Discard)).runServe(ctx, opts)
+	}()
+
+	var resp *http.Response
+	for range 50 {
+		if resp, err = http.Get("http://" + addr + "/healthz"); err == nil {
+			break
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	if err != nil {
+		t.Fatalf("server did not start: %v", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("GET /healthz status = %d, want 200", resp.StatusCode)
+	}
+
+	cancel()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("runServe() error = %v, want a clean shutdown", err)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("server did not shut down")
+	}
+}
+
+func TestRunServeRejectsLimits(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*serveOptions)
+	}{
+		{name: "body size", modify: func(o *serveOptions) { o.maxBodyMB = 0 }},
+		{name: "timeout", modify: func(o *serveOptions) { o.timeout = -time.Second }},
+		{name: "concurrency", modify: func(o *serveOptions) { o.maxConcurrent = 0 }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := defaultServeOptions()
+			tt.modify(opts)
+
+			err := New(logger.NewConsoleLogger(io.Discard)).runServe(context.Background(), opts)
+			if err == nil || !strings.Contains(err.Error(), "must be positive") {
+				t.Errorf("runServe() error = %v, want a limit error", err)
+			}
+		})
+	}
+}
+
+func TestServeAllowPlugins(t *testing.T) {
+	tests := []struct {
+		name         string
+		allowPlugins bool
+	}{
+		{name: "default"},
+		{name: "allowed", allowPlugins: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := New(logger.NewConsoleLogger(io.Discard))
+			if err := c.formats.Register(pluginFormat("markdown", config.PluginConfig{Command: "markdown"})); err != nil {
+				t.Fatalf("failed to register the plugin: %v", err)
+			}
+
+			opts := defaultServeOptions()
+			opts.allowPlugins = tt.allowPlugins
+
+			srv := httptest.NewServer(c.newServer(opts).Handler())
+			defer srv.Close()
+
+			resp, err := http.Get(srv.URL + "/formats")
+			if err != nil {
+				t.Fatalf("GET /formats failed: %v", err)
+			}
+			defer resp.Body.Close()
+
+			var formats []struct {
+				Name string `json:"name"`
+			}
+			if err := json.NewDecoder(resp.Body).Decode(&formats); err != nil {
+				t.Fatalf("failed to decode formats: %v", err)
+			}
+
+			var names []string
+			for _, format := range formats {
+				names = append(names, format.Name)
+			}
+
+			if served := slices.Contains(names, "markdown"); served != tt.allowPlugins {
+				t.Errorf("formats = %v, plugin served = %v, want %v", names, served, tt.allowPlugins)
+			}
+		})
+	}
+}
+
+func TestServeAllowPluginsFlag(t *testing.T) {
+	flag := New(logger.NewConsoleLogger(io.Discard)).newServeCommand().Flags().Lookup("allow-plugins")
+	if flag == nil || flag.DefValue != "false" {
+		t.Errorf("--allow-plugins = %+v, want a flag that is off by default", flag)
+	}
+}
@@ -0,0 +1,139 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/GabrielNunesIT/openapi-converter/internal/domain"
+)
+
+// splitIndexName is the base name of the index document of a split.
+const splitIndexName = "index"
+
+// splitMarkdownEscaper escapes the characters of tag names that CommonMark
+// would read as markup.
+var splitMarkdownEscaper = strings.NewReplacer(
+	`\`, `\\`, "`", "\\`", "*", `\*`, "_", `\_`, "[", `\[`, "]", `\]`, "<", `\<`, "#", `\#`,
+)
+
+type splitEntry struct {
+	Tag         string
+	Description string
+	File        string
+	Endpoints   int
+}
+
+var splitFileNamePattern = regexp.MustCompile(`[^a-z0-9]+`)
+
+// runSplit writes one document per tag into the output directory, each
+// titled after its tag, along with an index page linking them.
+func (c *CLI) runSplit(ctx context.Context, doc *domain.OpenAPIDocument, converter domain.Converter) error {
+	ext := "." + converter.Format()
+	if format, ok := c.formats.Lookup(converter.Format()); ok && format.Extension != "" {
+		ext = format.Extension
+	} else if e, ok := converter.(interface{ Extension() string }); ok && e.Extension() != "" {
+		ext = e.Extension()
+	}
+
+	if err := os.MkdirAll(c.outputFile, 0o755); err != nil {
+		return fmt.Errorf("failed to create output directory: %w", err)
+	}
+
+	tagDescs := make(map[string]string)
+	for _, t := range doc.Tags {
+		tagDescs[t.Name] = t.Description
+	}
+
+	used := make(map[string]bool)
+	entries := make([]splitEntry, 0)
+
+	for _, tag := range doc.OperationTags() {
+		tagDoc := doc.ForTag(tag)
+		tagDoc.Title = fmt.Sprintf("%s - %s", doc.Title, tag)
+
+		fileName := splitFileName(tag, ext, used)
+		if err := c.writeDocument(ctx, converter, tagDoc, filepath.Join(c.outputFile, fileName)); err != nil {
+			return fmt.Errorf("failed to write document for tag %s: %w", tag, err)
+		}
+
+		endpoints := 0
+		for _, path := range tagDoc.Paths {
+			endpoints += len(path.Operations)
+		}
+
+		entries = append(entries, splitEntry{
+			Tag:         tag,
+			Description: firstLine(tagDescs[tag]),
+			File:        fileName,
+			Endpoints:   endpoints,
+		})
+	}
+
+	indexFile := filepath.Join(c.outputFile, splitIndexName+ext)
+	if err := c.writeDocument(ctx, converter, splitIndex(doc, entries), indexFile); err != nil {
+		return fmt.Errorf("failed to write index: %w", err)
+	}
+
+	c.log.Infof("Successfully created %d documents in: %s", len(entries), c.outputFile)
+
+	return nil
+}
+
+// writeDocument converts a document into the named file.
+func (c *CLI) writeDocument(ctx context.Context, converter domain.Converter, doc *domain.OpenAPIDocument, fileName string) error {
+	outputFile, err := os.Create(fileName)
+	if err != nil {
+		return fmt.Errorf("failed to create output file: %w", err)
+	}
+	defer outputFile.Close()
+
+	return c.convert(ctx, converter, doc, outputFile)
+}
+
+// splitIndex returns the index document of a split, whose description lists
+// the per-tag documents with links to their files.
+func splitIndex(doc *domain.OpenAPIDocument, entries []splitEntry) *domain.OpenAPIDocument {
+	var desc strings.Builder
+	for _, entry := range entries {
+		fmt.Fprintf(&desc, "- [%s](%s) (%d endpoints)", splitMarkdownEscaper.Replace(entry.Tag), entry.File, entry.Endpoints)
+		if entry.Description != "" {
+			fmt.Fprintf(&desc, " - %s", entry.Description)
+		}
+		desc.WriteString("\n")
+	}
+
+	return &domain.OpenAPIDocument{
+		Title:       doc.Title,
+		Version:     doc.Version,
+		Description: desc.String(),
+	}
+}
+
+// splitFileName derives a unique file name from a tag.
+func splitFileName(tag, ext string, used map[string]bool) string {
+	base := strings.Trim(splitFileNamePattern.ReplaceAllString(strings.ToLower(tag), "-"), "-")
+	switch base {
+	case "":
+		base = "tag"
+	case splitIndexName:
+		base = "tag-" + base
+	}
+
+	name := base
+	for n := 2; used[name]; n++ {
+		name = fmt.Sprintf("%s-%d", base, n)
+	}
+	used[name] = true
+
+	return name + ext
+}
+
+func firstLine(s string) string {
+	line, _, _ := strings.Cut(strings.TrimSpace(s), "\n")
+
+	return line
+}
@@ -5,25 +5,61 @@ import (
 	configloader "github.com/GabrielNunesIT/go-libs/config-loader"
 )
 
+// EnvPrefix is the prefix of environment variables overriding configuration,
+// e.g. OPENAPI_CONVERTER_CONFLUENCE_TOKEN sets confluence.token.
+const EnvPrefix = "OPENAPI_CONVERTER_"
+
 // Config holds the application configuration.
-// This is a placeholder for future configuration options.
 type Config struct {
-	// Add configuration fields here as needed
-	// Example:
-	// OutputDir string `koanf:"output_dir"`
-	// LogLevel  string `koanf:"log_level"`
+	Confluence ConfluenceConfig `koanf:"confluence"`
+	Compat     CompatConfig     `koanf:"compat"`
+	Plugins    PluginsConfig    `koanf:"plugins"`
+}
+
+// ConfluenceConfig holds the settings used to publish to Confluence.
+type ConfluenceConfig struct {
+	URL    string `koanf:"url"`    // Base URL, e.g. https://example.atlassian.net/wiki
+	Space  string `koanf:"space"`  // Space key
+	Parent string `koanf:"parent"` // ID of the page new pages are created under
+	User   string `koanf:"user"`   // User name or email; empty to use Token as a bearer token
+	Token  string `koanf:"token"`  // API token or personal access token
+}
+
+// CompatConfig holds the settings of the compatibility check.
+type CompatConfig struct {
+	Rules     map[string]string `koanf:"rules"`     // Severity per rule: error, warning, info or off
+	Allowlist string            `koanf:"allowlist"` // Path to the file of accepted breaking changes
+}
+
+// PluginsConfig holds the settings of external converter plugins.
+type PluginsConfig struct {
+	Dirs    []string                `koanf:"dirs"`    // Directories searched for plugins before PATH
+	Formats map[string]PluginConfig `koanf:"formats"` // Plugins by format name
+}
+
+// PluginConfig describes a plugin executable and the files it writes.
+type PluginConfig struct {
+	Command     string `koanf:"command"`     // Path to the executable
+	Extension   string `koanf:"extension"`   // Output file extension, including the dot
+	MIMEType    string `koanf:"mimetype"`    // MIME type of the output
+	Description string `koanf:"description"` // Shown by the formats command
 }
 
 // Load returns the application configuration using go-libs config-loader.
-func Load() (*Config, error) {
+// Values are read from the optional configuration file, then overridden by
+// environment variables.
+func Load(configFile string) (*Config, error) {
 	defaults := Config{}
 
-	loader := configloader.NewConfigLoader(
+	opts := []configloader.Option[Config]{
 		configloader.WithDefaults(defaults),
-		// Future: Add file, env, flags support
-		// configloader.WithFile[Config]("config.yaml"),
-		// configloader.WithEnv[Config]("OPENAPI_"),
-	)
+	}
+	if configFile != "" {
+		opts = append(opts, configloader.WithFile[Config](configFile))
+	}
+	opts = append(opts, configloader.WithEnv[Config](EnvPrefix))
+
+	loader := configloader.NewConfigLoader(opts...)
 
 	cfg, err := loader.Load()
 	if err != nil {
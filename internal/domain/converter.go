@@ -1,6 +1,9 @@
 package domain
 
-import "io"
+import (
+	"context"
+	"io"
+)
 
 // Converter defines the interface for document converters.
 type Converter interface {
@@ -10,3 +13,30 @@ type Converter interface {
 	// Format returns the output format name (e.g., "pdf", "docx").
 	Format() string
 }
+
+// ContextConverter is a converter that can be cancelled and reports its
+// progress while it renders a document.
+type ContextConverter interface {
+	Converter
+
+	// ConvertContext transforms an OpenAPI document to the target format,
+	// reporting its progress to opts.Progress and stopping with the context's
+	// error once it is done. It returns warnings about parts of the document
+	// that could not be rendered as written.
+	ConvertContext(ctx context.Context, doc *OpenAPIDocument, output io.Writer, opts ConvertOptions) ([]Warning, error)
+}
+
+// ConvertOptions configures a conversion.
+type ConvertOptions struct {
+	// Progress is called as the sections and endpoints of the document are
+	// rendered. It may be nil.
+	Progress func(Progress)
+}
+
+// Progress describes how far a conversion has got.
+type Progress struct {
+	Section  string // Top-level section being rendered
+	Endpoint string // Endpoint being rendered, as "GET /pets"
+	Done     int    // Endpoints rendered so far
+	Total    int    // Endpoints the document renders
+}
@@ -0,0 +1,22 @@
+package domain
+
+import "context"
+
+// Warning reports a part of a specification that cannot be represented in a
+// document, or of a document that cannot be rendered.
+type Warning struct {
+	Location string `json:"location,omitempty"` // Where the warning applies, e.g. "GET /pets response 200"
+	Message  string `json:"message"`
+}
+
+// SpecLoader defines the interface for loading API specifications.
+type SpecLoader interface {
+	// Load reads the specification at source, a file path, an http(s) URL or
+	// "-" for standard input. Constructs the document cannot represent are
+	// left out and reported as warnings.
+	Load(ctx context.Context, source string) (*OpenAPIDocument, []Warning, error)
+
+	// LoadData reads a specification from JSON or YAML data, resolving
+	// relative references against location, which may be empty.
+	LoadData(data []byte, location string) (*OpenAPIDocument, []Warning, error)
+}
@@ -0,0 +1,247 @@
+package domain
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode"
+)
+
+const componentRefPrefix = "#/components/schemas/"
+
+// MergeDocuments combines the documents of several services into one.
+// Each service gets a namespace derived from its title, unique even when
+// titles repeat, and becomes a chapter: its tags are prefixed with the
+// namespace and its component schemas are namespaced with it so names
+// cannot collide. Security schemes with the same name and definition are
+// shared; conflicting ones are namespaced as well. When version is empty the
+// services must share one version.
+func MergeDocuments(title, version string, docs []*OpenAPIDocument) (*OpenAPIDocument, error) {
+	if version == "" {
+		for _, doc := range docs {
+			if version != "" && doc.Version != version {
+				return nil, fmt.Errorf("services have different versions (%s, %s); set the version of the merged document",
+					version, doc.Version)
+			}
+			version = doc.Version
+		}
+	}
+
+	merged := &OpenAPIDocument{
+		Title:           title,
+		Version:         version,
+		Components:      make(map[string]Schema),
+		SecuritySchemes: make(map[string]SecurityScheme),
+	}
+
+	var desc strings.Builder
+	desc.WriteString("This reference combines the following services:\n")
+
+	namespaces := make(map[string]bool)
+	servers := make(map[string]bool)
+
+	for _, doc := range docs {
+		ns := uniqueNamespace(serviceNamespace(doc.Title), namespaces)
+		m := &serviceMerger{namespace: ns}
+
+		fmt.Fprintf(&desc, "\n- **%s** (version %s)", doc.Title, doc.Version)
+		if summary := strings.TrimSpace(strings.SplitN(doc.Description, "\n\n", 2)[0]); summary != "" {
+			fmt.Fprintf(&desc, ": %s", strings.ReplaceAll(summary, "\n", " "))
+		}
+
+		m.mergeSecurity(merged, doc)
+
+		for _, server := range doc.Servers {
+			if servers[server.URL] {
+				continue
+			}
+			servers[server.URL] = true
+
+			if server.Description != "" {
+				server.Description = fmt.Sprintf("%s: %s", doc.Title, server.Description)
+			} else {
+				server.Description = doc.Title
+			}
+			merged.Servers = append(merged.Servers, server)
+		}
+
+		for _, tag := range doc.Tags {
+			merged.Tags = append(merged.Tags, Tag{Name: m.tag(tag.Name), Description: tag.Description})
+		}
+
+		for _, path := range doc.Paths {
+			merged.Paths = append(merged.Paths, m.path(path))
+		}
+
+		for name, schema := range doc.Components {
+			merged.Components[m.component(name)] = m.schema(schema)
+		}
+	}
+
+	merged.Description = desc.String()
+
+	return merged, nil
+}
+
+// serviceMerger rewrites the names used by one service as it is merged.
+type serviceMerger struct {
+	namespace string
+	schemes   map[string]string // Original security scheme names to merged names
+}
+
+func (m *serviceMerger) tag(name string) string {
+	return fmt.Sprintf("%s / %s", m.namespace, name)
+}
+
+func (m *serviceMerger) component(name string) string {
+	return m.namespace + "." + name
+}
+
+func (m *serviceMerger) mergeSecurity(merged, doc *OpenAPIDocument) {
+	m.schemes = make(map[string]string)
+
+	for name, scheme := range doc.SecuritySchemes {
+		target := name
+		if existing, ok := merged.SecuritySchemes[name]; ok && !reflect.DeepEqual(existing, scheme) {
+			target = m.namespace + "." + name
+		}
+
+		merged.SecuritySchemes[target] = scheme
+		m.schemes[name] = target
+	}
+
+	for _, requirement := range doc.Security {
+		renamed := make(map[string][]string, len(requirement))
+		for name, scopes := range requirement {
+			if target, ok := m.schemes[name]; ok {
+				name = target
+			}
+			renamed[name] = scopes
+		}
+
+		if !containsRequirement(merged.Security, renamed) {
+			merged.Security = append(merged.Security, renamed)
+		}
+	}
+}
+
+func containsRequirement(requirements []map[string][]string, requirement map[string][]string) bool {
+	for _, r := range requirements {
+		if reflect.DeepEqual(r, requirement) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (m *serviceMerger) path(path Path) Path {
+	result := Path{Path: path.Path, Operations: make([]Operation, 0, len(path.Operations))}
+
+	for _, op := range path.Operations {
+		tags := op.Tags
+		if len(tags) == 0 {
+			tags = []string{DefaultTag}
+		}
+
+		op.Tags = make([]string, len(tags))
+		for i, tag := range tags {
+			op.Tags[i] = m.tag(tag)
+		}
+
+		params := make([]Parameter, len(op.Parameters))
+		for i, param := range op.Parameters {
+			param.Schema = m.schema(param.Schema)
+			params[i] = param
+		}
+		op.Parameters = params
+
+		if op.RequestBody != nil {
+			body := *op.RequestBody
+			body.Content = m.content(body.Content)
+			op.RequestBody = &body
+		}
+
+		responses := make([]Response, len(op.Responses))
+		for i, resp := range op.Responses {
+			resp.Content = m.content(resp.Content)
+			responses[i] = resp
+		}
+		op.Responses = responses
+
+		result.Operations = append(result.Operations, op)
+	}
+
+	return result
+}
+
+func (m *serviceMerger) content(content map[string]MediaType) map[string]MediaType {
+	if content == nil {
+		return nil
+	}
+
+	result := make(map[string]MediaType, len(content))
+	for mediaType, media := range content {
+		media.Schema = m.schema(media.Schema)
+		result[mediaType] = media
+	}
+
+	return result
+}
+
+// schema returns a copy of a schema with component references namespaced.
+func (m *serviceMerger) schema(schema Schema) Schema {
+	if schema.Ref != "" {
+		name := schema.Ref[strings.LastIndex(schema.Ref, "/")+1:]
+		schema.Ref = componentRefPrefix + m.component(name)
+	}
+
+	if schema.Properties != nil {
+		props := make(map[string]Schema, len(schema.Properties))
+		for name, prop := range schema.Properties {
+			props[name] = m.schema(prop)
+		}
+		schema.Properties = props
+	}
+
+	if schema.Items != nil {
+		items := m.schema(*schema.Items)
+		schema.Items = &items
+	}
+
+	return schema
+}
+
+// serviceNamespace derives a component namespace from a service title, such
+// as "PetStore" from "Pet Store API".
+func serviceNamespace(title string) string {
+	var b strings.Builder
+
+	for _, word := range strings.FieldsFunc(title, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		if strings.EqualFold(word, "api") {
+			continue
+		}
+
+		runes := []rune(word)
+		b.WriteRune(unicode.ToUpper(runes[0]))
+		b.WriteString(string(runes[1:]))
+	}
+
+	if b.Len() == 0 {
+		return "Service"
+	}
+
+	return b.String()
+}
+
+func uniqueNamespace(base string, used map[string]bool) string {
+	ns := base
+	for n := 2; used[ns]; n++ {
+		ns = fmt.Sprintf("%s%d", base, n)
+	}
+	used[ns] = true
+
+	return ns
+}
@@ -0,0 +1,253 @@
+package domain_test
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/GabrielNunesIT/openapi-converter/internal/domain"
+)
+
+// service returns a document with one tagged operation using a Pet
+// component in its parameter, request body and response.
+func service(title, version string) *domain.OpenAPIDocument {
+	pet := domain.Schema{Ref: "#/components/schemas/Pet"}
+
+	return &domain.OpenAPIDocument{
+		Title:   title,
+		Version: version,
+		Tags:    []domain.Tag{{Name: "pets"}},
+		Paths: []domain.Path{{
+			Path: "/pets",
+			Operations: []domain.Operation{{
+				Method:     "post",
+				Tags:       []string{"pets"},
+				Parameters: []domain.Parameter{{Name: "filter", In: "query", Schema: pet}},
+				RequestBody: &domain.RequestBody{
+					Content: map[string]domain.MediaType{"application/json": {Schema: pet}},
+				},
+				Responses: []domain.Response{{
+					StatusCode: "200",
+					Content: map[string]domain.MediaType{
+						"application/json": {Schema: domain.Schema{Type: "array", Items: &pet}},
+					},
+				}},
+			}},
+		}},
+		Components: map[string]domain.Schema{
+			"Pet": {
+				Type:       "object",
+				Properties: map[string]domain.Schema{"owner": {Ref: "#/components/schemas/Owner"}},
+			},
+			"Owner": {Type: "object"},
+		},
+	}
+}
+
+// refs collects the references used by the operations and components of a
+// document.
+func refs(doc *domain.OpenAPIDocument) []string {
+	var result []string
+
+	var walk func(schema domain.Schema)
+	walk = func(schema domain.Schema) {
+		if schema.Ref != "" {
+			result = append(result, schema.Ref)
+		}
+		for _, prop := range schema.Properties {
+			walk(prop)
+		}
+		if schema.Items != nil {
+			walk(*schema.Items)
+		}
+	}
+
+	for _, path := range doc.Paths {
+		for _, op := range path.Operations {
+			for _, param := range op.Parameters {
+				walk(param.Schema)
+			}
+			if op.RequestBody != nil {
+				for _, media := range op.RequestBody.Content {
+					walk(media.Schema)
+				}
+			}
+			for _, resp := range op.Responses {
+				for _, media := range resp.Content {
+					walk(media.Schema)
+				}
+			}
+		}
+	}
+	for _, schema := range doc.Components {
+		walk(schema)
+	}
+
+	slices.Sort(result)
+
+	return slices.Compact(result)
+}
+
+func TestMergeDocumentsNamespaces(t *testing.T) {
+	tests := []struct {
+		name       string
+		docs       []*domain.OpenAPIDocument
+		tags       []string
+		components []string
+		refs       []string
+	}{
+		{
+			name:       "single service",
+			docs:       []*domain.OpenAPIDocument{service("Pet Store API", "1.0")},
+			tags:       []string{"PetStore / pets"},
+			components: []string{"PetStore.Owner", "PetStore.Pet"},
+			refs:       []string{"#/components/schemas/PetStore.Owner", "#/components/schemas/PetStore.Pet"},
+		},
+		{
+			name:       "different titles",
+			docs:       []*domain.OpenAPIDocument{service("Pets API", "1.0"), service("Store API", "1.0")},
+			tags:       []string{"Pets / pets", "Store / pets"},
+			components: []string{"Pets.Owner", "Pets.Pet", "Store.Owner", "Store.Pet"},
+			refs: []string{
+				"#/components/schemas/Pets.Owner", "#/components/schemas/Pets.Pet",
+				"#/components/schemas/Store.Owner", "#/components/schemas/Store.Pet",
+			},
+		},
+		{
+			name:       "same titles",
+			docs:       []*domain.OpenAPIDocument{service("Pets API", "1.0"), service("Pets API", "1.0")},
+			tags:       []string{"Pets / pets", "Pets2 / pets"},
+			components: []string{"Pets.Owner", "Pets.Pet", "Pets2.Owner", "Pets2.Pet"},
+			refs: []string{
+				"#/components/schemas/Pets.Owner", "#/components/schemas/Pets.Pet",
+				"#/components/schemas/Pets2.Owner", "#/components/schemas/Pets2.Pet",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			merged, err := domain.MergeDocuments("Shop", "", tt.docs)
+			if err != nil {
+				t.Fatalf("MergeDocuments() error = %v", err)
+			}
+
+			var tags []string
+			for _, tag := range merged.Tags {
+				tags = append(tags, tag.Name)
+			}
+			if !slices.Equal(tags, tt.tags) {
+				t.Errorf("tags = %v, want %v", tags, tt.tags)
+			}
+
+			// Each service's operations are in its own chapter
+			for i, path := range merged.Paths {
+				if got := path.Operations[0].Tags; !slices.Equal(got, tt.tags[i:i+1]) {
+					t.Errorf("operation %d tags = %v, want %v", i, got, tt.tags[i:i+1])
+				}
+			}
+
+			var components []string
+			for name := range merged.Components {
+				components = append(components, name)
+			}
+			slices.Sort(components)
+			if !slices.Equal(components, tt.components) {
+				t.Errorf("components = %v, want %v", components, tt.components)
+			}
+
+			if got := refs(merged); !slices.Equal(got, tt.refs) {
+				t.Errorf("references = %v, want %v", got, tt.refs)
+			}
+		})
+	}
+}
+
+func TestMergeDocumentsSecuritySchemes(t *testing.T) {
+	bearer := domain.SecurityScheme{Type: "http", Scheme: "bearer"}
+	apiKey := domain.SecurityScheme{Type: "apiKey", Name: "X-Key", In: "header"}
+
+	secured := func(title string, scheme domain.SecurityScheme) *domain.OpenAPIDocument {
+		doc := service(title, "1.0")
+		doc.SecuritySchemes = map[string]domain.SecurityScheme{"auth": scheme}
+		doc.Security = []map[string][]string{{"auth": {}}}
+
+		return doc
+	}
+
+	tests := []struct {
+		name     string
+		docs     []*domain.OpenAPIDocument
+		schemes  map[string]domain.SecurityScheme
+		security []map[string][]string
+	}{
+		{
+			name:     "identical schemes",
+			docs:     []*domain.OpenAPIDocument{secured("Pets API", bearer), secured("Store API", bearer)},
+			schemes:  map[string]domain.SecurityScheme{"auth": bearer},
+			security: []map[string][]string{{"auth": {}}},
+		},
+		{
+			name:     "conflicting schemes",
+			docs:     []*domain.OpenAPIDocument{secured("Pets API", bearer), secured("Store API", apiKey)},
+			schemes:  map[string]domain.SecurityScheme{"auth": bearer, "Store.auth": apiKey},
+			security: []map[string][]string{{"auth": {}}, {"Store.auth": {}}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			merged, err := domain.MergeDocuments("Shop", "", tt.docs)
+			if err != nil {
+				t.Fatalf("MergeDocuments() error = %v", err)
+			}
+
+			if !reflect.DeepEqual(merged.SecuritySchemes, tt.schemes) {
+				t.Errorf("security schemes = %v, want %v", merged.SecuritySchemes, tt.schemes)
+			}
+			if !reflect.DeepEqual(merged.Security, tt.security) {
+				t.Errorf("security = %v, want %v", merged.Security, tt.security)
+			}
+		})
+	}
+}
+
+func TestMergeDocumentsVersion(t *testing.T) {
+	tests := []struct {
+		name     string
+		version  string
+		versions []string
+		want     string
+		wantErr  string
+	}{
+		{name: "shared version", versions: []string{"1.0", "1.0"}, want: "1.0"},
+		{name: "different versions", versions: []string{"1.0", "2.0"}, wantErr: "different versions (1.0, 2.0)"},
+		{name: "explicit version", version: "3.0", versions: []string{"1.0", "2.0"}, want: "3.0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var docs []*domain.OpenAPIDocument
+			for _, version := range tt.versions {
+				docs = append(docs, service("Pets API", version))
+			}
+
+			merged, err := domain.MergeDocuments("Shop", tt.version, docs)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("MergeDocuments() error = %v, want it to contain %q", err, tt.wantErr)
+				}
+
+				return
+			}
+			if err != nil {
+				t.Fatalf("MergeDocuments() error = %v", err)
+			}
+
+			if merged.Version != tt.want {
+				t.Errorf("version = %q, want %q", merged.Version, tt.want)
+			}
+		})
+	}
+}
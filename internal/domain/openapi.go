@@ -3,92 +3,106 @@ package domain
 
 // OpenAPIDocument represents a parsed OpenAPI specification.
 type OpenAPIDocument struct {
-	Title       string
-	Version     string
-	Description string
-	Servers     []Server
-	Tags        []Tag
-	Paths       []Path
-	Components  map[string]Schema // Schema components (key is schema name)
-	SecuritySchemes map[string]SecurityScheme
-	Security        []map[string][]string
+	Title           string                    `json:"title,omitempty"`
+	Version         string                    `json:"version,omitempty"`
+	Description     string                    `json:"description,omitempty"`
+	Servers         []Server                  `json:"servers,omitempty"`
+	Tags            []Tag                     `json:"tags,omitempty"`
+	Paths           []Path                    `json:"paths,omitempty"`
+	Components      map[string]Schema         `json:"components,omitempty"` // Schema components (key is schema name)
+	SecuritySchemes map[string]SecurityScheme `json:"securitySchemes,omitempty"`
+	Security        []map[string][]string     `json:"security,omitempty"`
 }
 
 // SecurityScheme represents a security scheme.
 type SecurityScheme struct {
-	Type        string
-	Name        string
-	Description string
-	In          string
-	Scheme      string
+	Type        string `json:"type,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	In          string `json:"in,omitempty"`
+	Scheme      string `json:"scheme,omitempty"`
 }
 
 // Server represents an API server.
 type Server struct {
-	URL         string
-	Description string
+	URL         string `json:"url,omitempty"`
+	Description string `json:"description,omitempty"`
 }
 
 // Tag represents an OpenAPI tag.
 type Tag struct {
-	Name        string
-	Description string
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
 }
 
 // Path represents an API endpoint path.
 type Path struct {
-	Path       string
-	Operations []Operation
+	Path       string      `json:"path,omitempty"`
+	Operations []Operation `json:"operations,omitempty"`
 }
 
 // Operation represents an HTTP operation on a path.
 type Operation struct {
-	Method      string
-	Summary     string
-	Description string
-	OperationID string
-	Tags        []string
-	Parameters  []Parameter
-	RequestBody *RequestBody
-	Responses   []Response
+	Method      string       `json:"method,omitempty"`
+	Summary     string       `json:"summary,omitempty"`
+	Description string       `json:"description,omitempty"`
+	OperationID string       `json:"operationId,omitempty"`
+	Tags        []string     `json:"tags,omitempty"`
+	Parameters  []Parameter  `json:"parameters,omitempty"`
+	RequestBody *RequestBody `json:"requestBody,omitempty"`
+	Responses   []Response   `json:"responses,omitempty"`
 }
 
 // Parameter represents a request parameter.
 type Parameter struct {
-	Name        string
-	In          string // query, path, header, cookie
-	Description string
-	Required    bool
-	Schema      Schema
+	Name        string `json:"name,omitempty"`
+	In          string `json:"in,omitempty"` // query, path, header, cookie
+	Description string `json:"description,omitempty"`
+	Required    bool   `json:"required,omitempty"`
+	Schema      Schema `json:"schema,omitempty"`
 }
 
 // RequestBody represents a request body.
 type RequestBody struct {
-	Description string
-	Required    bool
-	Content     map[string]MediaType
+	Description string               `json:"description,omitempty"`
+	Required    bool                 `json:"required,omitempty"`
+	Content     map[string]MediaType `json:"content,omitempty"`
 }
 
 // MediaType represents the content type and schema.
 type MediaType struct {
-	Schema   Schema
-	Example  interface{}
-	Examples map[string]interface{}
+	Schema   Schema                 `json:"schema,omitempty"`
+	Example  interface{}            `json:"example,omitempty"`
+	Examples map[string]interface{} `json:"examples,omitempty"`
 }
 
 // Response represents an API response.
 type Response struct {
-	StatusCode  string
-	Description string
-	Content     map[string]MediaType
+	StatusCode  string               `json:"statusCode,omitempty"`
+	Description string               `json:"description,omitempty"`
+	Content     map[string]MediaType `json:"content,omitempty"`
 }
 
 // Schema represents a JSON schema for request/response bodies.
 type Schema struct {
-	Type        string
-	Format      string
-	Description string
-	Properties  map[string]Schema
-	Items       *Schema
-	Ref         string
+	Type        string            `json:"type,omitempty"`
+	Format      string            `json:"format,omitempty"`
+	Description string            `json:"description,omitempty"`
+	Properties  map[string]Schema `json:"properties,omitempty"`
+	Required    []string          `json:"required,omitempty"` // Names of the properties that must be present
+	Items       *Schema           `json:"items,omitempty"`
+	Ref         string            `json:"ref,omitempty"`
+
+	// Value constraints and sample values
+	Enum             []interface{} `json:"enum,omitempty"`
+	Default          interface{}   `json:"default,omitempty"`
+	Example          interface{}   `json:"example,omitempty"`
+	Minimum          *float64      `json:"minimum,omitempty"`
+	Maximum          *float64      `json:"maximum,omitempty"`
+	ExclusiveMinimum bool          `json:"exclusiveMinimum,omitempty"` // Minimum itself is excluded
+	ExclusiveMaximum bool          `json:"exclusiveMaximum,omitempty"` // Maximum itself is excluded
+	MinLength        uint64        `json:"minLength,omitempty"`
+	MaxLength        *uint64       `json:"maxLength,omitempty"`
+	MinItems         uint64        `json:"minItems,omitempty"`
+	MaxItems         *uint64       `json:"maxItems,omitempty"`
 }
@@ -0,0 +1,72 @@
+package domain
+
+import "sort"
+
+// DefaultTag groups operations that have no tags.
+const DefaultTag = "Default"
+
+// OperationTags returns the sorted names of the tags used by operations,
+// including DefaultTag when some operation has no tags.
+func (d *OpenAPIDocument) OperationTags() []string {
+	seen := make(map[string]bool)
+
+	for _, path := range d.Paths {
+		for _, op := range path.Operations {
+			for _, tag := range operationTags(op) {
+				seen[tag] = true
+			}
+		}
+	}
+
+	tags := make([]string, 0, len(seen))
+	for tag := range seen {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+
+	return tags
+}
+
+// ForTag returns a copy of the document holding only the operations and
+// definition of a tag, with the operations tagged with it alone. Paths left
+// without operations are dropped; the rest of the document is shared with the
+// original.
+func (d *OpenAPIDocument) ForTag(tag string) *OpenAPIDocument {
+	filtered := *d
+	filtered.Paths = nil
+	filtered.Tags = nil
+
+	for _, t := range d.Tags {
+		if t.Name == tag {
+			filtered.Tags = append(filtered.Tags, t)
+		}
+	}
+
+	for _, path := range d.Paths {
+		var ops []Operation
+		for _, op := range path.Operations {
+			for _, t := range operationTags(op) {
+				if t == tag {
+					// Keep converters from also grouping it under its other tags
+					op.Tags = []string{tag}
+					ops = append(ops, op)
+					break
+				}
+			}
+		}
+
+		if len(ops) > 0 {
+			filtered.Paths = append(filtered.Paths, Path{Path: path.Path, Operations: ops})
+		}
+	}
+
+	return &filtered
+}
+
+func operationTags(op Operation) []string {
+	if len(op.Tags) == 0 {
+		return []string{DefaultTag}
+	}
+
+	return op.Tags
+}
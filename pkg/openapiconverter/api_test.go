@@ -0,0 +1,151 @@
+package openapiconverter_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	oc "github.com/GabrielNunesIT/openapi-converter/pkg/openapiconverter"
+)
+
+// The exported API as programs embedding the converter use it. Most names
+// are aliases of internal types, so these assignments make any change to
+// them that would break such programs fail to compile here first.
+var (
+	_ func(string) (*oc.Document, []oc.Warning, error)                  = oc.LoadFile
+	_ func([]byte, string) (*oc.Document, []oc.Warning, error)          = oc.LoadData
+	_ func(io.Reader, string) (*oc.Document, []oc.Warning, error)       = oc.LoadReader
+	_ func(context.Context, string) (*oc.Document, []oc.Warning, error) = oc.LoadURL
+
+	_ func(...oc.LoaderOption) *oc.Loader                                           = oc.NewLoader
+	_ func(*http.Client) oc.LoaderOption                                            = oc.WithHTTPClient
+	_ func(bool) oc.LoaderOption                                                    = oc.WithExternalRefs
+	_ func(*oc.Loader, context.Context, string) (*oc.Document, []oc.Warning, error) = (*oc.Loader).Load
+	_ func(*oc.Loader, string) (*oc.Document, []oc.Warning, error)                  = (*oc.Loader).LoadFile
+	_ func(*oc.Loader, []byte, string) (*oc.Document, []oc.Warning, error)          = (*oc.Loader).LoadData
+	_ func(*oc.Loader, io.Reader, string) (*oc.Document, []oc.Warning, error)       = (*oc.Loader).LoadReader
+	_ func(*oc.Loader, context.Context, string) (*oc.Document, []oc.Warning, error) = (*oc.Loader).LoadURL
+
+	_ func(*oc.Document, string, oc.Settings, io.Writer) error = oc.Convert
+	_ func(context.Context, *oc.Document, string, oc.Settings, io.Writer, oc.ConvertOptions) (
+		[]oc.Warning, error) = oc.ConvertContext
+	_ func(context.Context, oc.Converter, *oc.Document, io.Writer, oc.ConvertOptions) (
+		[]oc.Warning, error) = oc.ConvertWith
+	_ func(string, string, ...*oc.Document) (*oc.Document, error) = oc.Merge
+
+	_ func(...oc.Format) (*oc.Registry, error)                      = oc.NewRegistry
+	_ func() *oc.Registry                                           = oc.DefaultRegistry
+	_ func(*oc.Registry, oc.Format) error                           = (*oc.Registry).Register
+	_ func(*oc.Registry, string) (oc.Format, bool)                  = (*oc.Registry).Lookup
+	_ func(*oc.Registry, string) (oc.Format, bool)                  = (*oc.Registry).ForFile
+	_ func(*oc.Registry, string, oc.Settings) (oc.Converter, error) = (*oc.Registry).New
+	_ func(*oc.Registry) []oc.Format                                = (*oc.Registry).Formats
+	_ func(*oc.Registry) []string                                   = (*oc.Registry).Names
+
+	_ func(...oc.PDFOption) *oc.PDFConverter = oc.NewPDFConverter
+	_ func(string) oc.PDFOption              = oc.WithPageSize
+	_ func(string) oc.PDFOption              = oc.WithOrientation
+	_ func(oc.PDFMargins) oc.PDFOption       = oc.WithMargins
+	_ func(bool) oc.PDFOption                = oc.WithPDFGeneratedExamples
+
+	_ func(...oc.DocxOption) *oc.DocxConverter = oc.NewDocxConverter
+	_ func(string) oc.DocxOption               = oc.WithReferenceDoc
+	_ func(bool) oc.DocxOption                 = oc.WithDocxGeneratedExamples
+
+	_ func(...oc.ODTOption) *oc.ODTConverter           = oc.NewODTConverter
+	_ func(bool) oc.ODTOption                          = oc.WithODTGeneratedExamples
+	_ func(...oc.AsciiDocOption) *oc.AsciiDocConverter = oc.NewAsciiDocConverter
+	_ func(bool) oc.AsciiDocOption                     = oc.WithAsciiDocGeneratedExamples
+	_ func(...oc.ADFOption) *oc.ADFConverter           = oc.NewADFConverter
+	_ func(bool) oc.ADFOption                          = oc.WithADFGeneratedExamples
+
+	_ func(...oc.ConfluenceStorageOption) *oc.ConfluenceStorageConverter = oc.NewConfluenceStorageConverter
+	_ func(bool) oc.ConfluenceStorageOption                              = oc.WithConfluenceStorageGeneratedExamples
+
+	_ func(...oc.TemplateOption) *oc.TemplateConverter = oc.NewTemplateConverter
+	_ func(...string) oc.TemplateOption                = oc.WithTemplateFiles
+	_ func(string) oc.TemplateOption                   = oc.WithTemplateEntry
+	_ func(bool) oc.TemplateOption                     = oc.WithHTMLTemplates
+
+	_ oc.ContextConverter = (*oc.PDFConverter)(nil)
+	_ oc.ContextConverter = (*oc.DocxConverter)(nil)
+	_ oc.ContextConverter = (*oc.ODTConverter)(nil)
+	_ oc.ContextConverter = (*oc.AsciiDocConverter)(nil)
+	_ oc.ContextConverter = (*oc.ADFConverter)(nil)
+	_ oc.ContextConverter = (*oc.ConfluenceStorageConverter)(nil)
+	_ oc.Converter        = (*oc.TemplateConverter)(nil)
+
+	_ = oc.Settings{
+		GenerateExamples: true,
+		PageSize:         oc.PDFPageA4,
+		Orientation:      oc.PDFPortrait,
+		Margins:          &oc.PDFMargins{Top: 1, Right: 1, Bottom: 1, Left: 1},
+		ReferenceDoc:     "",
+		TemplateFiles:    []string{},
+		TemplateEntry:    "",
+	}
+	_ = oc.Format{
+		Name:        "",
+		Aliases:     []string{},
+		Extension:   "",
+		MIMEType:    "",
+		Description: "",
+		New:         oc.Factory(func(oc.Settings) oc.Converter { return nil }),
+		Plugin:      false,
+	}
+	_ = oc.ConvertOptions{Progress: func(oc.Progress) {}}
+	_ = oc.Progress{Section: "", Endpoint: "", Done: 0, Total: 0}
+	_ = oc.Warning{Location: "", Message: ""}
+	_ = oc.Document{
+		Title:           "",
+		Version:         "",
+		Description:     "",
+		Servers:         []oc.Server{},
+		Tags:            []oc.Tag{},
+		Paths:           []oc.Path{},
+		Components:      map[string]oc.Schema{},
+		SecuritySchemes: map[string]oc.SecurityScheme{},
+		Security:        []map[string][]string{},
+	}
+)
+
+// TestDefaultRegistryFormats checks the built-in format names and
+// extensions, which scripts and configuration files refer to.
+func TestDefaultRegistryFormats(t *testing.T) {
+	tests := []struct {
+		name      string
+		aliases   []string
+		extension string
+	}{
+		{name: "pdf", extension: ".pdf"},
+		{name: "docx", extension: ".docx"},
+		{name: "odt", extension: ".odt"},
+		{name: "asciidoc", aliases: []string{"adoc"}, extension: ".adoc"},
+		{name: "confluence", aliases: []string{"adf"}, extension: ".adf.json"},
+		{name: "confluence-storage", aliases: []string{"storage"}, extension: ".xhtml"},
+		{name: oc.TemplateFormat},
+	}
+
+	registry := oc.DefaultRegistry()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, name := range append([]string{tt.name}, tt.aliases...) {
+				format, ok := registry.Lookup(name)
+				if !ok {
+					t.Fatalf("Lookup(%q) found no format", name)
+				}
+
+				if format.Name != tt.name || format.Extension != tt.extension {
+					t.Errorf("Lookup(%q) = %s with extension %q, want %s with %q",
+						name, format.Name, format.Extension, tt.name, tt.extension)
+				}
+			}
+		})
+	}
+
+	if got := len(registry.Formats()); got != len(tests) {
+		t.Errorf("got %d built-in formats, want %d", got, len(tests))
+	}
+}
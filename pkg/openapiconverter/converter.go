@@ -0,0 +1,91 @@
+package openapiconverter
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/GabrielNunesIT/openapi-converter/internal/adapters/converters"
+	"github.com/GabrielNunesIT/openapi-converter/internal/domain"
+)
+
+// Converter renders a Document in an output format.
+type Converter = domain.Converter
+
+// ContextConverter is a Converter that can be cancelled and reports its
+// progress. All built-in converters except the template converter are.
+type ContextConverter = domain.ContextConverter
+
+// ConvertOptions configures a conversion run with ConvertContext.
+type ConvertOptions = domain.ConvertOptions
+
+// Progress describes how far a conversion has got.
+type Progress = domain.Progress
+
+// Warning describes a part of a document that could not be loaded or
+// rendered as written.
+type Warning = domain.Warning
+
+// Registry holds output formats by name.
+type Registry = converters.Registry
+
+// Format describes an output format and how to create its converter.
+type Format = converters.Format
+
+// Factory creates the converter of a format.
+type Factory = converters.Factory
+
+// Settings holds the options converters created through a Registry get.
+type Settings = converters.Settings
+
+// NewRegistry creates a registry holding the given formats.
+func NewRegistry(formats ...Format) (*Registry, error) {
+	return converters.NewRegistry(formats...)
+}
+
+// DefaultRegistry returns a new registry of the built-in formats, to which
+// custom formats can be added.
+func DefaultRegistry() *Registry {
+	return converters.DefaultRegistry()
+}
+
+// Convert renders a document in a built-in format, given by name or alias.
+func Convert(doc *Document, format string, settings Settings, w io.Writer) error {
+	converter, err := DefaultRegistry().New(format, settings)
+	if err != nil {
+		return err
+	}
+
+	if err := converter.Convert(doc, w); err != nil {
+		return fmt.Errorf("conversion failed: %w", err)
+	}
+
+	return nil
+}
+
+// ConvertContext renders a document in a built-in format like Convert,
+// stopping once ctx is done and reporting progress through opts. It returns
+// the warnings of the conversion.
+func ConvertContext(ctx context.Context, doc *Document, format string, settings Settings, w io.Writer,
+	opts ConvertOptions,
+) ([]Warning, error) {
+	converter, err := DefaultRegistry().New(format, settings)
+	if err != nil {
+		return nil, err
+	}
+
+	warnings, err := converters.Convert(ctx, converter, doc, w, opts)
+	if err != nil {
+		return warnings, fmt.Errorf("conversion failed: %w", err)
+	}
+
+	return warnings, nil
+}
+
+// ConvertWith runs a converter created directly or through a Registry.
+// Context converters stop once ctx is done and report progress through
+// opts; other converters only check ctx before they start.
+func ConvertWith(ctx context.Context, converter Converter, doc *Document, w io.Writer, opts ConvertOptions,
+) ([]Warning, error) {
+	return converters.Convert(ctx, converter, doc, w, opts)
+}
@@ -0,0 +1,26 @@
+// Package openapiconverter is the public API for embedding the OpenAPI
+// converter in other programs. It loads OpenAPI 3.x specifications into
+// Documents and renders them with the converters of the command-line tool:
+//
+//	doc, warnings, err := openapiconverter.LoadFile("api.yaml")
+//	if err != nil {
+//		return err
+//	}
+//	for _, warning := range warnings {
+//		log.Printf("%s: %s", warning.Location, warning.Message)
+//	}
+//
+//	converter := openapiconverter.NewPDFConverter(openapiconverter.WithPageSize(openapiconverter.PDFPageLetter))
+//	return converter.Convert(doc, w)
+//
+// Converters can also be created by format name through a Registry, which
+// accepts custom formats as well:
+//
+//	err := openapiconverter.Convert(doc, "docx", openapiconverter.Settings{GenerateExamples: true}, w)
+//
+// ConvertContext does the same with a context that cancels the conversion, a
+// callback receiving its progress, and the warnings it produced as a result.
+//
+// The exported names of this package follow semantic versioning; the
+// packages under internal/ may change at any time.
+package openapiconverter
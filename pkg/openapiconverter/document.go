@@ -0,0 +1,30 @@
+package openapiconverter
+
+import "github.com/GabrielNunesIT/openapi-converter/internal/domain"
+
+// Document is an OpenAPI specification in the form converters render.
+type Document = domain.OpenAPIDocument
+
+// Types making up a Document.
+type (
+	SecurityScheme = domain.SecurityScheme
+	Server         = domain.Server
+	Tag            = domain.Tag
+	Path           = domain.Path
+	Operation      = domain.Operation
+	Parameter      = domain.Parameter
+	RequestBody    = domain.RequestBody
+	MediaType      = domain.MediaType
+	Response       = domain.Response
+	Schema         = domain.Schema
+)
+
+// DefaultTag groups operations that have no tags.
+const DefaultTag = domain.DefaultTag
+
+// Merge combines the documents of several services into one, namespacing
+// their tags and components. When version is empty the services must share
+// one version.
+func Merge(title, version string, docs ...*Document) (*Document, error) {
+	return domain.MergeDocuments(title, version, docs)
+}
@@ -0,0 +1,163 @@
+package openapiconverter_test
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"strings"
+
+	oc "github.com/GabrielNunesIT/openapi-converter/pkg/openapiconverter"
+)
+
+const petstore = `openapi: 3.0.3
+info:
+  title: Pets API
+  version: 1.0.0
+paths:
+  /pets:
+    get:
+      summary: List pets
+      tags: [pets]
+      responses:
+        "200":
+          description: The pets
+    post:
+      summary: Add a pet
+      tags: [pets]
+      callbacks:
+        added:
+          "{$request.body#/callback}":
+            post:
+              responses:
+                "204":
+                  description: Received
+      responses:
+        "201":
+          description: Added
+`
+
+func ExampleLoadData() {
+	doc, warnings, err := oc.LoadData([]byte(petstore), "")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Println(doc.Title, doc.Version)
+	for _, warning := range warnings {
+		fmt.Printf("warning: %s: %s\n", warning.Location, warning.Message)
+	}
+
+	// Output:
+	// Pets API 1.0.0
+	// warning: POST /pets: Callbacks are not rendered
+}
+
+func ExampleConvert() {
+	doc, _, err := oc.LoadData([]byte(petstore), "")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var out bytes.Buffer
+	if err := oc.Convert(doc, "adoc", oc.Settings{}, &out); err != nil {
+		log.Fatal(err)
+	}
+
+	title, _, _ := strings.Cut(out.String(), "\n")
+	fmt.Println(title)
+
+	// Output:
+	// = Pets API
+}
+
+func ExampleConvertContext() {
+	doc, _, err := oc.LoadData([]byte(petstore), "")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var last oc.Progress
+	opts := oc.ConvertOptions{Progress: func(p oc.Progress) { last = p }}
+
+	if _, err := oc.ConvertContext(context.Background(), doc, "pdf", oc.Settings{}, io.Discard, opts); err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Printf("rendered %d of %d endpoints\n", last.Done, last.Total)
+
+	// Output:
+	// rendered 2 of 2 endpoints
+}
+
+// endpointsConverter writes the endpoints of a document, one per line.
+type endpointsConverter struct{}
+
+func (endpointsConverter) Format() string { return "endpoints" }
+
+func (endpointsConverter) Convert(doc *oc.Document, w io.Writer) error {
+	out := bufio.NewWriter(w)
+	for _, path := range doc.Paths {
+		for _, op := range path.Operations {
+			fmt.Fprintf(out, "%s %s\n", strings.ToUpper(op.Method), path.Path)
+		}
+	}
+
+	return out.Flush()
+}
+
+func ExampleRegistry_Register() {
+	registry := oc.DefaultRegistry()
+
+	err := registry.Register(oc.Format{
+		Name:      "endpoints",
+		Extension: ".endpoints.txt",
+		New:       func(oc.Settings) oc.Converter { return endpointsConverter{} },
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	format, _ := registry.ForFile("api.endpoints.txt")
+	converter, err := registry.New(format.Name, oc.Settings{})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	doc, _, err := oc.LoadData([]byte(petstore), "")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var out bytes.Buffer
+	if _, err := oc.ConvertWith(context.Background(), converter, doc, &out, oc.ConvertOptions{}); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Print(out.String())
+
+	// Output:
+	// GET /pets
+	// POST /pets
+}
+
+func ExampleMerge() {
+	pets := &oc.Document{Title: "Pets", Version: "1.0", Paths: []oc.Path{{Path: "/pets"}}}
+	store := &oc.Document{Title: "Store", Version: "1.0", Paths: []oc.Path{{Path: "/orders"}}}
+
+	merged, err := oc.Merge("Shop", "", pets, store)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Println(merged.Title, merged.Version)
+	for _, path := range merged.Paths {
+		fmt.Println(path.Path)
+	}
+
+	// Output:
+	// Shop 1.0
+	// /pets
+	// /orders
+}
@@ -0,0 +1,142 @@
+package openapiconverter
+
+import "github.com/GabrielNunesIT/openapi-converter/internal/adapters/converters"
+
+// PDF page sizes and orientations.
+const (
+	PDFPageA3     = converters.PDFPageA3
+	PDFPageA4     = converters.PDFPageA4
+	PDFPageA5     = converters.PDFPageA5
+	PDFPageLetter = converters.PDFPageLetter
+	PDFPageLegal  = converters.PDFPageLegal
+
+	PDFPortrait  = converters.PDFPortrait
+	PDFLandscape = converters.PDFLandscape
+)
+
+// TemplateFormat is the registry name of the format rendering Go templates,
+// which needs Settings.TemplateFiles.
+const TemplateFormat = converters.TemplateFormat
+
+// Converters and their options.
+type (
+	PDFConverter               = converters.PDFConverter
+	PDFOption                  = converters.PDFOption
+	PDFMargins                 = converters.PDFMargins
+	DocxConverter              = converters.DocxConverter
+	DocxOption                 = converters.DocxOption
+	ODTConverter               = converters.ODTConverter
+	ODTOption                  = converters.ODTOption
+	AsciiDocConverter          = converters.AsciiDocConverter
+	AsciiDocOption             = converters.AsciiDocOption
+	ADFConverter               = converters.ADFConverter
+	ADFOption                  = converters.ADFOption
+	ConfluenceStorageConverter = converters.ConfluenceStorageConverter
+	ConfluenceStorageOption    = converters.ConfluenceStorageOption
+	TemplateConverter          = converters.TemplateConverter
+	TemplateOption             = converters.TemplateOption
+)
+
+// NewPDFConverter creates a PDF converter.
+func NewPDFConverter(opts ...PDFOption) *PDFConverter {
+	return converters.NewPDFConverter(opts...)
+}
+
+// WithPageSize sets the PDF page size, one of the PDFPage constants.
+func WithPageSize(size string) PDFOption {
+	return converters.WithPageSize(size)
+}
+
+// WithOrientation sets the PDF page orientation.
+func WithOrientation(orientation string) PDFOption {
+	return converters.WithOrientation(orientation)
+}
+
+// WithMargins sets the PDF page margins in millimetres.
+func WithMargins(margins PDFMargins) PDFOption {
+	return converters.WithMargins(margins)
+}
+
+// WithPDFGeneratedExamples generates examples for bodies that have none.
+func WithPDFGeneratedExamples(enabled bool) PDFOption {
+	return converters.WithPDFGeneratedExamples(enabled)
+}
+
+// NewDocxConverter creates a Word converter.
+func NewDocxConverter(opts ...DocxOption) *DocxConverter {
+	return converters.NewDocxConverter(opts...)
+}
+
+// WithReferenceDoc reuses the styles, headers, footers and cover page of a
+// .docx or .dotx document.
+func WithReferenceDoc(fileName string) DocxOption {
+	return converters.WithReferenceDoc(fileName)
+}
+
+// WithDocxGeneratedExamples generates examples for bodies that have none.
+func WithDocxGeneratedExamples(enabled bool) DocxOption {
+	return converters.WithDocxGeneratedExamples(enabled)
+}
+
+// NewODTConverter creates an OpenDocument Text converter.
+func NewODTConverter(opts ...ODTOption) *ODTConverter {
+	return converters.NewODTConverter(opts...)
+}
+
+// WithODTGeneratedExamples generates examples for bodies that have none.
+func WithODTGeneratedExamples(enabled bool) ODTOption {
+	return converters.WithODTGeneratedExamples(enabled)
+}
+
+// NewAsciiDocConverter creates an AsciiDoc converter.
+func NewAsciiDocConverter(opts ...AsciiDocOption) *AsciiDocConverter {
+	return converters.NewAsciiDocConverter(opts...)
+}
+
+// WithAsciiDocGeneratedExamples generates examples for bodies that have none.
+func WithAsciiDocGeneratedExamples(enabled bool) AsciiDocOption {
+	return converters.WithAsciiDocGeneratedExamples(enabled)
+}
+
+// NewADFConverter creates a converter writing Confluence Cloud pages in
+// Atlassian Document Format.
+func NewADFConverter(opts ...ADFOption) *ADFConverter {
+	return converters.NewADFConverter(opts...)
+}
+
+// WithADFGeneratedExamples generates examples for bodies that have none.
+func WithADFGeneratedExamples(enabled bool) ADFOption {
+	return converters.WithADFGeneratedExamples(enabled)
+}
+
+// NewConfluenceStorageConverter creates a converter writing Confluence
+// Server and Data Center pages in storage format.
+func NewConfluenceStorageConverter(opts ...ConfluenceStorageOption) *ConfluenceStorageConverter {
+	return converters.NewConfluenceStorageConverter(opts...)
+}
+
+// WithConfluenceStorageGeneratedExamples generates examples for bodies that
+// have none.
+func WithConfluenceStorageGeneratedExamples(enabled bool) ConfluenceStorageOption {
+	return converters.WithConfluenceStorageGeneratedExamples(enabled)
+}
+
+// NewTemplateConverter creates a converter rendering Go templates.
+func NewTemplateConverter(opts ...TemplateOption) *TemplateConverter {
+	return converters.NewTemplateConverter(opts...)
+}
+
+// WithTemplateFiles adds template files, given as paths or glob patterns.
+func WithTemplateFiles(patterns ...string) TemplateOption {
+	return converters.WithTemplateFiles(patterns...)
+}
+
+// WithTemplateEntry sets the name of the template to execute.
+func WithTemplateEntry(name string) TemplateOption {
+	return converters.WithTemplateEntry(name)
+}
+
+// WithHTMLTemplates parses the templates with html/template.
+func WithHTMLTemplates(enabled bool) TemplateOption {
+	return converters.WithHTMLTemplates(enabled)
+}
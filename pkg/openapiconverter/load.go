@@ -0,0 +1,54 @@
+package openapiconverter
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	"github.com/GabrielNunesIT/openapi-converter/internal/adapters/loaders"
+)
+
+// Loader reads OpenAPI 3.x specifications, in JSON or YAML, into Documents.
+// External references are followed unless disabled with WithExternalRefs.
+type Loader = loaders.OpenAPILoader
+
+// LoaderOption configures a Loader.
+type LoaderOption = loaders.OpenAPILoaderOption
+
+// NewLoader creates a Loader.
+func NewLoader(opts ...LoaderOption) *Loader {
+	return loaders.NewOpenAPILoader(opts...)
+}
+
+// WithHTTPClient sets the client a Loader fetches URLs with.
+func WithHTTPClient(client *http.Client) LoaderOption {
+	return loaders.WithHTTPClient(client)
+}
+
+// WithExternalRefs sets whether a Loader follows references to other files
+// and URLs. Disable it for specifications from untrusted sources.
+func WithExternalRefs(enabled bool) LoaderOption {
+	return loaders.WithExternalRefs(enabled)
+}
+
+// LoadFile loads a specification from a file. The warnings describe the
+// parts of it a Document cannot represent.
+func LoadFile(path string) (*Document, []Warning, error) {
+	return NewLoader().LoadFile(path)
+}
+
+// LoadData loads a specification from data. Relative references resolve
+// against location, a file path or URL, which may be empty.
+func LoadData(data []byte, location string) (*Document, []Warning, error) {
+	return NewLoader().LoadData(data, location)
+}
+
+// LoadReader loads a specification read from r.
+func LoadReader(r io.Reader, location string) (*Document, []Warning, error) {
+	return NewLoader().LoadReader(r, location)
+}
+
+// LoadURL loads a specification from an http(s) URL.
+func LoadURL(ctx context.Context, url string) (*Document, []Warning, error) {
+	return NewLoader().LoadURL(ctx, url)
+}